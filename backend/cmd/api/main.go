@@ -27,12 +27,7 @@ func main() {
 	// Create a new router
 	router := mux.NewRouter()
 
-	routes.RegisterHealthRoutes(router)
-	routes.RegisterAuthRoutes(router)
-	routes.RegisterYahooRoutes(router)
-	routes.RegisterNHLRoutes(router)
-	routes.RegisterStatsRoutes(router)
-	routes.RegisterSearchRoutes(router)
+	routes.RegisterRoutes(router)
 
 	// Define allowed CORS options
 	corsOptions := handlers.CORS(
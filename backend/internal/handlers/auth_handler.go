@@ -3,10 +3,13 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
 
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
 )
@@ -64,18 +67,19 @@ func YahooCallback(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, redirectURL.String(), http.StatusFound)
 }
 
-type ClearCacheRequest struct {
-	Operation string `json:"operation"` // Optional: Specifies which cache operation to clear
-}
-
 func ClearCache(w http.ResponseWriter, r *http.Request) {
-	var req ClearCacheRequest
+	var req models.ClearCacheRequest
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	// The body is optional so DELETE requests can omit it
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
 		utils.CustomResponse(w, http.StatusBadRequest, "Invalid request body", err.Error())
 		return
 	}
 
+	if operation := mux.Vars(r)["operation"]; operation != "" {
+		req.Operation = operation
+	}
+
 	err := services.ClearCache(req.Operation)
 	if err != nil {
 		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to clear cache", err.Error())
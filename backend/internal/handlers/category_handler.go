@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetLeagueCategoryAnalysis(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	cachedCategories, err := services.GetCachedResponse(leagueId, "getcategories")
+	if err != nil {
+		log.Printf("Failed to read cached category analysis: %v", err)
+	}
+
+	if cachedCategories != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved category analysis from cache", cachedCategories)
+		return
+	}
+
+	categories, err := services.GetLeagueCategoryAnalysis(userSession, leagueId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to compute category analysis", err.Error())
+		}
+		return
+	}
+
+	err = services.CacheResponse(leagueId, "getcategories", categories, utils.GetTTL())
+	if err != nil {
+		log.Printf("Failed to cache category analysis: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully computed category analysis", categories)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const maxComparedPlayers = 5
+
+func ComparePlayers(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	var playerKeys []string
+	seen := make(map[string]bool)
+	for _, playerKey := range strings.Split(r.URL.Query().Get("players"), ",") {
+		playerKey = strings.TrimSpace(playerKey)
+		if playerKey == "" || seen[playerKey] {
+			continue
+		}
+		seen[playerKey] = true
+		playerKeys = append(playerKeys, playerKey)
+	}
+
+	if len(playerKeys) < 2 || len(playerKeys) > maxComparedPlayers {
+		utils.CustomResponse(w, http.StatusBadRequest, "Between 2 and 5 distinct player keys are required", nil)
+		return
+	}
+
+	comparison, err := services.ComparePlayers(userSession, leagueId, playerKeys)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to compare players", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully compared players", comparison)
+}
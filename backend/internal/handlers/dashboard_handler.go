@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetUserDashboard(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	// Not cached: matchup scores and lineups change throughout the day
+	dashboard, err := services.GetUserDashboard(userSession)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to build user dashboard", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully built user dashboard", dashboard)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetDraftResults(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	picks, err := services.GetDraftResults(userSession, leagueId)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get draft results", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved draft results", picks)
+}
+
+func AnalyzeDraft(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	cachedAnalysis, err := services.GetCachedResponse(leagueId, "getdraftanalysis")
+	if err != nil {
+		log.Printf("Failed to read cached draft analysis: %v", err)
+	}
+
+	if cachedAnalysis != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved draft analysis from cache", cachedAnalysis)
+		return
+	}
+
+	analysis, err := services.AnalyzeDraft(userSession, leagueId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, "No draft results found", err.Error())
+			return
+		}
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to analyze draft", err.Error())
+		return
+	}
+
+	if err := services.CacheResponse(leagueId, "getdraftanalysis", analysis, utils.GetTTL()); err != nil {
+		log.Printf("Failed to cache draft analysis: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully analyzed draft", analysis)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func ArchiveLeagueHistory(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	seasons, err := services.ArchiveLeagueHistory(userSession, leagueId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to archive league history", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully archived league history", seasons)
+}
+
+func GetLeagueHistory(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	history, err := services.GetLeagueHistory(userSession, leagueId, r.URL.Query().Get("manager"))
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get league history", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved league history", history)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const maxLineupDays = 31
+
+func OptimizeTeamLineup(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	teamId := vars["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	startDate := r.URL.Query().Get("start")
+	if startDate == "" {
+		startDate = time.Now().Format("2006-01-02")
+	}
+	endDate := r.URL.Query().Get("end")
+	if endDate == "" {
+		endDate = startDate
+	}
+
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid start date, expected YYYY-MM-DD", nil)
+		return
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid end date, expected YYYY-MM-DD", nil)
+		return
+	}
+	if end.Before(start) || end.Sub(start) >= maxLineupDays*24*time.Hour {
+		utils.CustomResponse(w, http.StatusBadRequest, "End date must be on or after the start date and within 31 days", nil)
+		return
+	}
+
+	optimization, err := services.OptimizeTeamLineup(userSession, teamId, startDate, endDate)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to optimize lineup", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully optimized lineup", optimization)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetMatchupPreview(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	teamId := mux.Vars(r)["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	week := r.URL.Query().Get("week")
+	if week != "" && week != "next" {
+		if _, err := strconv.Atoi(week); err != nil {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid week, expected a number or next", nil)
+			return
+		}
+	}
+
+	simulations := services.DefaultPreviewSimulations
+	if value := r.URL.Query().Get("simulations"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > services.MaxPreviewSimulations {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid simulations, expected 1 to 100000", nil)
+			return
+		}
+		simulations = parsed
+	}
+
+	// Not cached: actual points change throughout the week and each run is a fresh simulation
+	preview, err := services.GetMatchupPreview(userSession, teamId, week, simulations)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else if utils.IsBadRequestError(err) {
+			utils.CustomResponse(w, http.StatusBadRequest, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to build matchup preview", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully built matchup preview", preview)
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func CreateMockDraft(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	var req models.MockDraftRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	if req.Rounds < 0 {
+		utils.CustomResponse(w, http.StatusBadRequest, "Rounds must be positive", nil)
+		return
+	}
+
+	state, err := services.CreateMockDraft(userSession, leagueId, req)
+	if err != nil {
+		writeMockDraftError(w, "Failed to create mock draft", err)
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully created mock draft", state)
+}
+
+func GetMockDraft(w http.ResponseWriter, r *http.Request) {
+	draftId, ok := parseDraftId(w, r)
+	if !ok {
+		return
+	}
+
+	state, err := services.GetMockDraft(draftId)
+	if err != nil {
+		writeMockDraftError(w, "Failed to get mock draft", err)
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved mock draft", state)
+}
+
+func RecordMockDraftPick(w http.ResponseWriter, r *http.Request) {
+	draftId, ok := parseDraftId(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.MockDraftPickRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	if req.PlayerKey == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing player key", nil)
+		return
+	}
+
+	state, err := services.RecordMockDraftPick(draftId, req.PlayerKey)
+	if err != nil {
+		writeMockDraftError(w, "Failed to record pick", err)
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully recorded pick", state)
+}
+
+func UndoMockDraftPick(w http.ResponseWriter, r *http.Request) {
+	draftId, ok := parseDraftId(w, r)
+	if !ok {
+		return
+	}
+
+	state, err := services.UndoMockDraftPick(draftId)
+	if err != nil {
+		writeMockDraftError(w, "Failed to undo pick", err)
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully undid the last pick", state)
+}
+
+func parseDraftId(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	draftId, err := strconv.ParseUint(mux.Vars(r)["draftId"], 10, 32)
+	if err != nil || draftId == 0 {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid draft Id", nil)
+		return 0, false
+	}
+	return uint(draftId), true
+}
+
+func writeMockDraftError(w http.ResponseWriter, message string, err error) {
+	if utils.IsNotFoundError(err) {
+		utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+	} else if utils.IsBadRequestError(err) {
+		utils.CustomResponse(w, http.StatusBadRequest, err.Error(), nil)
+	} else {
+		utils.CustomResponse(w, http.StatusInternalServerError, message, err.Error())
+	}
+}
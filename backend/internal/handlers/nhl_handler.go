@@ -1,14 +1,20 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
 )
 
+const defaultScheduleChangeDays = 7
+
 func SaveAllTeamsSchedule(w http.ResponseWriter, r *http.Request) {
 	if err := services.SaveAllTeamsSchedule(); err != nil {
 		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to save schedule", err)
@@ -18,10 +24,28 @@ func SaveAllTeamsSchedule(w http.ResponseWriter, r *http.Request) {
 	utils.CustomResponse(w, http.StatusOK, "Successfully saved schedule in DB", nil)
 }
 
+func GetScheduleChanges(w http.ResponseWriter, r *http.Request) {
+	since := r.URL.Query().Get("since")
+	if since == "" {
+		since = time.Now().AddDate(0, 0, -defaultScheduleChangeDays).Format("2006-01-02")
+	} else if _, err := time.Parse("2006-01-02", since); err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid since date, expected YYYY-MM-DD", nil)
+		return
+	}
+
+	changes, err := services.GetScheduleChanges(since, r.URL.Query().Get("team"))
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get schedule changes", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved schedule changes", changes)
+}
+
 func GetTeamNextGameDate(w http.ResponseWriter, r *http.Request) {
 
 	vars := mux.Vars(r)
-	team := vars["team"]
+	team := vars["teamAbrev"]
 
 	nextGame, err := repositories.GetTeamNextGameDate(team)
 	if err != nil {
@@ -46,7 +70,13 @@ func GetPlayerGameStats(w http.ResponseWriter, r *http.Request) {
 		season = utils.GetCurrentNhlSeason()
 	}
 
-	playerGameStats, err := services.GetPlayerGameStatsNHL(playerId, season)
+	gameType, ok := parseGameType(r.URL.Query().Get("gameType"))
+	if !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid game type, expected regular or playoffs", nil)
+		return
+	}
+
+	playerGameStats, err := services.GetPlayerGameStatsNHL(playerId, season, gameType)
 	if err != nil {
 		utils.CustomResponse(w, http.StatusInternalServerError, "Error getting player game stats", err)
 		return
@@ -88,3 +118,98 @@ func SavePlayerIDMapping(w http.ResponseWriter, r *http.Request) {
 
 	utils.CustomResponse(w, http.StatusOK, "Successfully mapped nhl and yahoo player ids", nil)
 }
+
+// parseGameType reads a "regular" or "playoffs" query value, defaulting to the regular season
+func parseGameType(value string) (int, bool) {
+	switch value {
+	case "", "regular":
+		return models.RegularSeasonGame, true
+	case "playoffs":
+		return models.PlayoffGame, true
+	}
+	return 0, false
+}
+
+func BackfillGameLogs(w http.ResponseWriter, r *http.Request) {
+	seasons := services.DefaultBackfillSeasons
+	if value := r.URL.Query().Get("seasons"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > services.MaxBackfillSeasons {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid seasons, expected 1 to 10", nil)
+			return
+		}
+		seasons = parsed
+	}
+
+	backfill, err := services.StartGameLogBackfill(seasons)
+	if err != nil {
+		if errors.Is(err, services.ErrBackfillRunning) {
+			utils.CustomResponse(w, http.StatusConflict, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to start game log backfill", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusAccepted, "Started game log backfill", backfill)
+}
+
+func GetGameLogBackfill(w http.ResponseWriter, r *http.Request) {
+	backfill, err := services.GetGameLogBackfill()
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve game log backfill", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved game log backfill", backfill)
+}
+
+func GetPlayerCareer(w http.ResponseWriter, r *http.Request) {
+	playerId := mux.Vars(r)["playerId"]
+	if playerId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing player id", nil)
+		return
+	}
+
+	career, err := services.GetPlayerCareer(playerId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get player career", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved player career", career)
+}
+
+func GetPlayerSeasonTotals(w http.ResponseWriter, r *http.Request) {
+	playerId := mux.Vars(r)["playerId"]
+	if playerId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing player id", nil)
+		return
+	}
+
+	gameType, ok := parseGameType(r.URL.Query().Get("gameType"))
+	if !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid game type, expected regular or playoffs", nil)
+		return
+	}
+
+	seasons, err := services.GetPlayerSeasonTotals(playerId, gameType)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get player season totals", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved player season totals", seasons)
+}
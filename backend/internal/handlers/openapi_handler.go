@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// GetOpenAPISpec serves the OpenAPI document generated from the given router.
+func GetOpenAPISpec(router *mux.Router) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		spec, err := services.BuildOpenAPISpec(router)
+		if err != nil {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to build OpenAPI spec", err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(spec)
+	}
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	defaultBacktestDays = 30
+	defaultGoalieDays   = 7
+	maxGoalieDays       = 31
+)
+
+func GetPlayerProjection(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	playerId := vars["playerId"]
+	if playerId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing Player Id", nil)
+		return
+	}
+
+	cachedProjection, err := services.GetCachedResponse(playerId+leagueId, "getprojection")
+	if err != nil {
+		log.Printf("Failed to read cached projection: %v", err)
+	}
+
+	if cachedProjection != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved player projection from cache", cachedProjection)
+		return
+	}
+
+	projection, err := services.GetPlayerProjection(userSession, leagueId, playerId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to project player", err.Error())
+		}
+		return
+	}
+
+	err = services.CacheResponse(playerId+leagueId, "getprojection", projection, utils.GetTTL())
+	if err != nil {
+		log.Printf("Failed to cache projection: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully projected player", projection)
+}
+
+func BacktestProjections(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	asOf := r.URL.Query().Get("asOf")
+	if asOf == "" {
+		asOf = time.Now().AddDate(0, 0, -defaultBacktestDays).Format("2006-01-02")
+	} else if _, err := time.Parse("2006-01-02", asOf); err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid asOf date, expected YYYY-MM-DD", nil)
+		return
+	}
+
+	backtest, err := services.BacktestProjections(userSession, leagueId, asOf)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to backtest projections", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully backtested projections", backtest)
+}
+
+func GetPlayerPointsSeries(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	playerId := vars["playerId"]
+	if playerId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing Player Id", nil)
+		return
+	}
+
+	season := r.URL.Query().Get("season")
+	if season != "" && len(season) != 8 {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid season, expected a value like 20242025", nil)
+		return
+	}
+
+	window := services.DefaultPointsWindow
+	if value := r.URL.Query().Get("window"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid window", nil)
+			return
+		}
+		window = parsed
+	}
+
+	series, err := services.GetPlayerPointsSeries(userSession, leagueId, playerId, season, window)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to build fantasy points series", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully built fantasy points series", series)
+}
+
+func GetGoalieOutlook(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	playerId := vars["playerId"]
+	if playerId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing Player Id", nil)
+		return
+	}
+
+	days := defaultGoalieDays
+	if value := r.URL.Query().Get("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxGoalieDays {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid days, expected 1 to 31", nil)
+			return
+		}
+		days = parsed
+	}
+
+	outlook, err := services.GetGoalieOutlook(userSession, leagueId, playerId, days)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to build goalie outlook", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully built goalie outlook", outlook)
+}
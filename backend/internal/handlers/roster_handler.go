@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// rosterCoverage reads the optional week or date query parameters
+func rosterCoverage(r *http.Request) (string, string, string) {
+	week := r.URL.Query().Get("week")
+	date := r.URL.Query().Get("date")
+
+	if week != "" {
+		if _, err := strconv.Atoi(week); err != nil {
+			return "", "", "Invalid week"
+		}
+	}
+
+	if date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return "", "", "Invalid date, expected YYYY-MM-DD"
+		}
+	}
+
+	return week, date, ""
+}
+
+func GetFantasyTeamRoster(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	teamId := vars["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	week, date, invalid := rosterCoverage(r)
+	if invalid != "" {
+		utils.CustomResponse(w, http.StatusBadRequest, invalid, nil)
+		return
+	}
+
+	roster, err := services.GetFantasyTeamRoster(userSession, teamId, week, date)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve team roster", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved team roster", roster)
+}
+
+func GetFantasyTeamRosterHistory(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	teamId := vars["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	history, err := services.GetFantasyTeamRosterHistory(teamId)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve roster history", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved roster history", history)
+}
+
+func GetLeagueRosters(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	week, date, invalid := rosterCoverage(r)
+	if invalid != "" {
+		utils.CustomResponse(w, http.StatusBadRequest, invalid, nil)
+		return
+	}
+
+	rosters, err := services.GetLeagueRosters(userSession, leagueId, week, date)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve league rosters", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved league rosters", rosters)
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetLeagueWeekSchedule(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	week := r.URL.Query().Get("week")
+	if week != "" {
+		if _, err := strconv.Atoi(week); err != nil {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid week", nil)
+			return
+		}
+	}
+
+	schedule, err := services.GetLeagueWeekSchedule(userSession, leagueId, week)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else if utils.IsBadRequestError(err) {
+			utils.CustomResponse(w, http.StatusBadRequest, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve week schedule", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved week schedule", schedule)
+}
+
+func GetTeamGamesRemaining(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	teamId := vars["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	week := r.URL.Query().Get("week")
+	if week != "" {
+		if _, err := strconv.Atoi(week); err != nil {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid week", nil)
+			return
+		}
+	}
+
+	remaining, err := services.GetTeamGamesRemaining(userSession, teamId, week)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else if utils.IsBadRequestError(err) {
+			utils.CustomResponse(w, http.StatusBadRequest, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve games remaining", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved games remaining", remaining)
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// scoreboardDate reads the date query, defaulting to today
+func scoreboardDate(r *http.Request) (string, bool) {
+	date := r.URL.Query().Get("date")
+	if date == "" {
+		return time.Now().Format("2006-01-02"), true
+	}
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return "", false
+	}
+	return date, true
+}
+
+func SyncScoreboard(w http.ResponseWriter, r *http.Request) {
+	date, ok := scoreboardDate(r)
+	if !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid date, expected YYYY-MM-DD", nil)
+		return
+	}
+
+	games, err := services.SyncScoreboard(date)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to sync scoreboard", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully synced scoreboard", games)
+}
+
+func GetScoreboard(w http.ResponseWriter, r *http.Request) {
+	date, ok := scoreboardDate(r)
+	if !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid date, expected YYYY-MM-DD", nil)
+		return
+	}
+
+	games, err := services.GetScoreboard(date)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get scoreboard", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved scoreboard", games)
+}
+
+func GetTeamToday(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	teamId := mux.Vars(r)["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	date, ok := scoreboardDate(r)
+	if !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid date, expected YYYY-MM-DD", nil)
+		return
+	}
+
+	today, err := services.GetTeamToday(userSession, teamId, date)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get today's games for team", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved today's games for team", today)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetLeagueStandings(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	cachedStandings, err := services.GetCachedResponse(leagueId, "getstandings")
+	if err != nil {
+		log.Printf("Failed to read cached standings: %v", err)
+	}
+
+	if cachedStandings != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved league standings from cache", cachedStandings)
+		return
+	}
+
+	standings, err := services.GetLeagueStandings(userSession, leagueId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve league standings", err.Error())
+		}
+		return
+	}
+
+	err = services.CacheResponse(leagueId, "getstandings", standings, utils.GetTTL())
+	if err != nil {
+		log.Printf("Failed to cache standings: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved league standings", standings)
+}
+
+func GetLeagueLuckAnalysis(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	cachedLuck, err := services.GetCachedResponse(leagueId, "getluck")
+	if err != nil {
+		log.Printf("Failed to read cached luck analysis: %v", err)
+	}
+
+	if cachedLuck != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved luck analysis from cache", cachedLuck)
+		return
+	}
+
+	luck, err := services.GetLeagueLuckAnalysis(userSession, leagueId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to compute luck analysis", err.Error())
+		}
+		return
+	}
+
+	err = services.CacheResponse(leagueId, "getluck", luck, utils.GetTTL())
+	if err != nil {
+		log.Printf("Failed to cache luck analysis: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully computed luck analysis", luck)
+}
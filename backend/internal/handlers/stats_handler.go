@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
 )
@@ -60,9 +62,9 @@ func GetFantasyLeaguePlayerStats(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Structure the response to include total points
-	response := map[string]interface{}{
-		"player_stats": leaguePlayerStats,
-		"total_points": totalPoints,
+	response := models.LeaguePlayerStatsResponse{
+		PlayerStats: leaguePlayerStats,
+		TotalPoints: totalPoints,
 	}
 
 	err = services.CacheResponse(playerId+leagueId, "getPlayerStats", response, utils.GetTTL())
@@ -82,22 +84,37 @@ func GetProjectedVsActual(w http.ResponseWriter, r *http.Request) {
 	}
 
 	vars := mux.Vars(r)
-	fTeamId := vars["fTeamId"]
+	fTeamId := vars["teamId"]
 
 	if fTeamId == "" {
 		utils.CustomResponse(w, http.StatusBadRequest, "Missing Fantasy Team Id", nil)
 		return
 	}
 
-	cachedStats, err := services.GetCachedResponse(fTeamId, "getProjectedvsExpected")
-
+	cachedStats, err := services.GetCachedResponse(fTeamId, "getprojectedvsactual")
 	if err != nil {
-		fmt.Errorf("%w", err)
+		log.Printf("Failed to read cached projected vs actual stats: %v", err)
 	}
 
 	if cachedStats != nil {
-		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved Projected vs Expected Stats from cache", cachedStats)
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved Projected vs Actual Stats from cache", cachedStats)
 		return
 	}
 
+	projectedVsActual, err := services.GetProjectedVsActual(userSession, fTeamId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to retrieve Projected vs Actual Stats", err.Error())
+		}
+		return
+	}
+
+	err = services.CacheResponse(fTeamId, "getprojectedvsactual", projectedVsActual, utils.GetTTL())
+	if err != nil {
+		log.Printf("Failed to cache projected vs actual stats: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved Projected vs Actual Stats", projectedVsActual)
 }
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetLeagueInjuries(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	injuries, err := services.GetLeagueInjuries(userSession, leagueId)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get league injuries", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved league injuries", injuries)
+}
+
+func GetPlayerStatusHistory(w http.ResponseWriter, r *http.Request) {
+	playerId := mux.Vars(r)["playerId"]
+	if playerId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing Player Id", nil)
+		return
+	}
+
+	history, err := services.GetPlayerStatusHistory(playerId)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get player status history", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved player status history", history)
+}
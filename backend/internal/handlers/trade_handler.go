@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func AnalyzeTrade(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	leagueId := vars["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	var req models.TradeAnalysisRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if req.TeamA == "" || req.TeamB == "" || req.TeamA == req.TeamB {
+		utils.CustomResponse(w, http.StatusBadRequest, "Two different teams are required", nil)
+		return
+	}
+
+	for _, teamId := range []string{req.TeamA, req.TeamB} {
+		teamLeague, err := utils.TeamtoLeagueId(teamId)
+		if err != nil || teamLeague != leagueId {
+			utils.CustomResponse(w, http.StatusBadRequest, "Team "+teamId+" is not in league "+leagueId, nil)
+			return
+		}
+	}
+
+	if len(req.TeamAGives) == 0 && len(req.TeamBGives) == 0 {
+		utils.CustomResponse(w, http.StatusBadRequest, "At least one player must be traded", nil)
+		return
+	}
+
+	if _, ok := services.WaiverWindows[req.Window]; req.Window != "" && !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid window, expected season, last7, last14 or last30", nil)
+		return
+	}
+
+	analysis, err := services.AnalyzeTrade(userSession, leagueId, req)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to analyze trade", err.Error())
+		}
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully analyzed trade", analysis)
+}
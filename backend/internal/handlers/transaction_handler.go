@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetLeagueTransactions(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := models.TransactionFilter{
+		Type:      query.Get("type"),
+		TeamKey:   query.Get("team"),
+		PlayerKey: query.Get("player"),
+		Since:     query.Get("since"),
+		Until:     query.Get("until"),
+	}
+
+	switch filter.Type {
+	case "", "add", "drop", "add/drop", "trade", "waiver":
+	default:
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid type, expected add, drop, add/drop, trade or waiver", nil)
+		return
+	}
+	for _, date := range []string{filter.Since, filter.Until} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid date, expected YYYY-MM-DD", nil)
+			return
+		}
+	}
+
+	transactions, err := services.GetLeagueTransactions(userSession, leagueId, filter)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to get league transactions", err.Error())
+		return
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully retrieved league transactions", transactions)
+}
+
+func AnalyzeLeagueTransactions(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	leagueId := mux.Vars(r)["leagueId"]
+	if leagueId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing league Id", nil)
+		return
+	}
+
+	cachedAnalysis, err := services.GetCachedResponse(leagueId, "gettransactionanalysis")
+	if err != nil {
+		log.Printf("Failed to read cached transaction analysis: %v", err)
+	}
+
+	if cachedAnalysis != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved transaction analysis from cache", cachedAnalysis)
+		return
+	}
+
+	analysis, err := services.AnalyzeLeagueTransactions(userSession, leagueId)
+	if err != nil {
+		utils.CustomResponse(w, http.StatusInternalServerError, "Failed to analyze league transactions", err.Error())
+		return
+	}
+
+	if err := services.CacheResponse(leagueId, "gettransactionanalysis", analysis, utils.GetTTL()); err != nil {
+		log.Printf("Failed to cache transaction analysis: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully analyzed league transactions", analysis)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	defaultWaiverDays  = 7
+	maxWaiverDays      = 14
+	defaultWaiverCount = 25
+)
+
+func GetWaiverRecommendations(w http.ResponseWriter, r *http.Request) {
+	userSession := r.Header.Get("user-session")
+	if userSession == "" {
+		utils.CustomResponse(w, http.StatusUnauthorized, "Missing user session", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	teamId := vars["teamId"]
+	if teamId == "" {
+		utils.CustomResponse(w, http.StatusBadRequest, "Missing team id", nil)
+		return
+	}
+
+	query := r.URL.Query()
+
+	window := query.Get("window")
+	if window == "" {
+		window = "season"
+	}
+	if _, ok := services.WaiverWindows[window]; !ok {
+		utils.CustomResponse(w, http.StatusBadRequest, "Invalid window, expected season, last7, last14 or last30", nil)
+		return
+	}
+
+	days := defaultWaiverDays
+	if value := query.Get("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxWaiverDays {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid days, expected 1 to 14", nil)
+			return
+		}
+		days = parsed
+	}
+
+	count := defaultWaiverCount
+	if value := query.Get("count"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > defaultWaiverCount {
+			utils.CustomResponse(w, http.StatusBadRequest, "Invalid count, expected 1 to 25", nil)
+			return
+		}
+		count = parsed
+	}
+
+	position := query.Get("position")
+
+	cacheId := fmt.Sprintf("%s|%s|%s|%d|%d", teamId, window, position, days, count)
+	cachedRecommendations, err := services.GetCachedResponse(cacheId, "getwaivers")
+	if err != nil {
+		log.Printf("Failed to read cached waiver recommendations: %v", err)
+	}
+
+	if cachedRecommendations != nil {
+		utils.CustomResponse(w, http.StatusOK, "Successfully retrieved waiver recommendations from cache", cachedRecommendations)
+		return
+	}
+
+	recommendations, err := services.GetWaiverRecommendations(userSession, teamId, window, position, days, count)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			utils.CustomResponse(w, http.StatusNotFound, err.Error(), nil)
+		} else {
+			utils.CustomResponse(w, http.StatusInternalServerError, "Failed to build waiver recommendations", err.Error())
+		}
+		return
+	}
+
+	err = services.CacheResponse(cacheId, "getwaivers", recommendations, utils.GetTTL())
+	if err != nil {
+		log.Printf("Failed to cache waiver recommendations: %v", err)
+	}
+
+	utils.CustomResponse(w, http.StatusOK, "Successfully built waiver recommendations", recommendations)
+}
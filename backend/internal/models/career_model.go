@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+type GameLogTotals struct {
+	Season            int     `json:"season,omitempty"`
+	GameType          int     `json:"gameType"`
+	Team              string  `json:"team,omitempty"` // Latest team that season
+	GamesPlayed       int     `json:"gamesPlayed"`
+	Goals             int     `json:"goals"`
+	Assists           int     `json:"assists"`
+	Points            int     `json:"points"`
+	PlusMinus         int     `json:"plusMinus"`
+	PIM               int     `json:"pim"`
+	PowerPlayPoints   int     `json:"powerPlayPoints"`
+	ShorthandedPoints int     `json:"shorthandedPoints"`
+	GameWinningGoals  int     `json:"gameWinningGoals"`
+	Shots             int     `json:"shots"`
+	PointsPerGame     float64 `json:"pointsPerGame"`
+	TOIPerGame        float64 `json:"toiPerGame"` // Minutes
+
+	GamesStarted   int     `json:"gamesStarted"`
+	Wins           int     `json:"wins"`
+	Losses         int     `json:"losses"`
+	OvertimeLosses int     `json:"overtimeLosses"`
+	Shutouts       int     `json:"shutouts"`
+	Saves          int     `json:"saves"`
+	GoalsAgainst   int     `json:"goalsAgainst"`
+	ShotsAgainst   int     `json:"shotsAgainst"`
+	SavePct        float64 `json:"savePct"`
+}
+
+type PlayerCareer struct {
+	NHLPlayerID   string          `json:"nhlPlayerId"`
+	RegularSeason GameLogTotals   `json:"regularSeason"`
+	Playoffs      GameLogTotals   `json:"playoffs"`
+	Seasons       []GameLogTotals `json:"seasons"` // One row per season and game type, oldest first
+}
+
+// Game log backfill states
+const (
+	BackfillRunning   = "running"
+	BackfillCompleted = "completed"
+)
+
+type GameLogBackfill struct {
+	Status      string     `json:"status"`
+	Seasons     []string   `json:"seasons"`
+	Players     int        `json:"players"`     // Mapped players to backfill
+	PlayersDone int        `json:"playersDone"` // Players whose seasons have all been requested
+	Games       int        `json:"games"`
+	Failures    []string   `json:"failures"`
+	StartedAt   time.Time  `json:"startedAt"`
+	FinishedAt  *time.Time `json:"finishedAt,omitempty"`
+}
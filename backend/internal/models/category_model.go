@@ -0,0 +1,35 @@
+package models
+
+type CategoryWeekResult struct {
+	Week     int    `json:"week"`
+	Opponent string `json:"opponent"`
+	Result   string `json:"result"` // W, L or T
+}
+
+type CategoryRecord struct {
+	StatID              string               `json:"statId"`
+	StatName            string               `json:"statName"`
+	Wins                int                  `json:"wins"`
+	Losses              int                  `json:"losses"`
+	Ties                int                  `json:"ties"`
+	WinPercentage       float64              `json:"winPercentage"`
+	RecentWinPercentage float64              `json:"recentWinPercentage"` // Over the most recent weeks
+	Trend               string               `json:"trend"`               // improving, declining or steady
+	Results             []CategoryWeekResult `json:"results"`
+}
+
+type TeamCategoryAnalysis struct {
+	TeamKey    string           `json:"teamKey"`
+	Name       string           `json:"name"`
+	Wins       int              `json:"wins"`
+	Losses     int              `json:"losses"`
+	Ties       int              `json:"ties"`
+	Categories []CategoryRecord `json:"categories"`
+	Dominant   []string         `json:"dominant"` // Categories won most weeks
+	Punted     []string         `json:"punted"`   // Categories lost most weeks
+}
+
+type LeagueCategoryResponse struct {
+	LeagueID string                 `json:"leagueId"`
+	Teams    []TeamCategoryAnalysis `json:"teams"`
+}
@@ -0,0 +1,31 @@
+package models
+
+type ComparedPlayer struct {
+	PlayerKey         string       `json:"playerKey"`
+	Name              string       `json:"name"`
+	Team              string       `json:"team"`
+	DisplayPosition   string       `json:"displayPosition"`
+	EligiblePositions []string     `json:"eligiblePositions"`
+	TotalPoints       float64      `json:"totalPoints"`
+	PointsPerGame     float64      `json:"pointsPerGame"`
+	RecentAverage     float64      `json:"recentAverage"` // Rolling fantasy points over the last games
+	CurrentForm       string       `json:"currentForm"`
+	RemainingGames    int          `json:"remainingGames"`
+	OpponentPointPct  float64      `json:"opponentPointPct"` // Average NHL point percentage of remaining opponents
+	Ranks             []PlayerRank `json:"ranks"`
+}
+
+type ComparisonRow struct {
+	Metric        string             `json:"metric"`
+	StatID        string             `json:"statId,omitempty"`
+	LowerIsBetter bool               `json:"lowerIsBetter"`
+	Values        map[string]float64 `json:"values"`     // Keyed by player key
+	Normalized    map[string]float64 `json:"normalized"` // 1 for the leader, scaled down for the rest
+	Leader        string             `json:"leader,omitempty"`
+}
+
+type PlayerComparison struct {
+	LeagueKey string           `json:"leagueKey"`
+	Players   []ComparedPlayer `json:"players"`
+	Rows      []ComparisonRow  `json:"rows"`
+}
@@ -10,3 +10,12 @@ type PlayerRanksResponse struct {
 	PlayerID    string       `json:"player_id"`    // Player ID
 	PlayerRanks []PlayerRank `json:"player_ranks"` // Player ranks
 }
+
+type LeaguePlayerStatsResponse struct {
+	PlayerStats *Player `json:"player_stats"` // Player stats adjusted by league stat modifiers
+	TotalPoints float64 `json:"total_points"` // Total fantasy points for the league
+}
+
+type ClearCacheRequest struct {
+	Operation string `json:"operation"` // Optional: Specifies which cache operation to clear
+}
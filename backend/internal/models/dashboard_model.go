@@ -0,0 +1,65 @@
+package models
+
+type UserLeague struct {
+	LeagueKey   string `json:"leagueKey"`
+	Name        string `json:"name"`
+	GameCode    string `json:"gameCode"`
+	Season      string `json:"season"`
+	NumTeams    int    `json:"numTeams"`
+	ScoringType string `json:"scoringType"`
+	DraftStatus string `json:"draftStatus"`
+	CurrentWeek int    `json:"currentWeek"`
+	StartDate   string `json:"startDate"`
+	EndDate     string `json:"endDate"`
+	IsFinished  bool   `json:"isFinished"`
+}
+
+type MatchupTeam struct {
+	TeamKey         string  `json:"teamKey"`
+	Name            string  `json:"name"`
+	Points          float64 `json:"points"`
+	ProjectedPoints float64 `json:"projectedPoints"` // Yahoo's projection for the full week
+	Stats           []Stat  `json:"stats,omitempty"`
+}
+
+type MatchupScore struct {
+	Week      int         `json:"week"`
+	Status    string      `json:"status"` // preevent, midevent or postevent
+	WeekStart string      `json:"weekStart"`
+	WeekEnd   string      `json:"weekEnd"`
+	Team      MatchupTeam `json:"team"`
+	Opponent  MatchupTeam `json:"opponent"`
+}
+
+const (
+	LineupIssueEmptySlot      = "empty_slot"
+	LineupIssueInjuredStarter = "injured_starter"
+	LineupIssueNoGame         = "no_game"
+)
+
+type LineupIssue struct {
+	Type       string `json:"type"`
+	Position   string `json:"position"`
+	PlayerKey  string `json:"playerKey,omitempty"`
+	PlayerName string `json:"playerName,omitempty"`
+	Detail     string `json:"detail"`
+}
+
+type LeagueDashboard struct {
+	LeagueKey    string        `json:"leagueKey"`
+	LeagueName   string        `json:"leagueName"`
+	Season       string        `json:"season"`
+	CurrentWeek  int           `json:"currentWeek"`
+	NumTeams     int           `json:"numTeams"`
+	TeamKey      string        `json:"teamKey"`
+	TeamName     string        `json:"teamName"`
+	Matchup      *MatchupScore `json:"matchup"` // Nil on bye weeks and before the season
+	Standing     *TeamStanding `json:"standing"`
+	LineupIssues []LineupIssue `json:"lineupIssues"`
+	Error        string        `json:"error,omitempty"` // Set when part of the league could not be loaded
+}
+
+type UserDashboard struct {
+	Date    string            `json:"date"`
+	Leagues []LeagueDashboard `json:"leagues"`
+}
@@ -0,0 +1,47 @@
+package models
+
+type DraftPick struct {
+	LeagueKey string `gorm:"primaryKey;column:league_key" json:"leagueKey"`
+	Pick      int    `gorm:"primaryKey;column:pick" json:"pick"`
+	Round     int    `gorm:"column:round" json:"round"`
+	TeamKey   string `gorm:"column:team_key" json:"teamKey"`
+	PlayerKey string `gorm:"column:player_key" json:"playerKey"`
+	Cost      int    `gorm:"column:cost" json:"cost,omitempty"` // Auction drafts only
+}
+
+type GradedPick struct {
+	Pick           int     `json:"pick"`
+	Round          int     `json:"round"`
+	TeamKey        string  `json:"teamKey"`
+	TeamName       string  `json:"teamName"`
+	PlayerKey      string  `json:"playerKey"`
+	PlayerName     string  `json:"playerName"`
+	Position       string  `json:"position"`
+	Points         float64 `json:"points"`
+	ValueRank      int     `json:"valueRank"`      // Rank of the player's points among all drafted players
+	ExpectedPoints float64 `json:"expectedPoints"` // Points of the player whose value rank matches the pick
+	Surplus        float64 `json:"surplus"`        // Points above the slot's expected points
+	Verdict        string  `json:"verdict,omitempty"`
+}
+
+type ManagerDraftGrade struct {
+	TeamKey        string  `json:"teamKey"`
+	TeamName       string  `json:"teamName"`
+	Picks          int     `json:"picks"`
+	Points         float64 `json:"points"`
+	ExpectedPoints float64 `json:"expectedPoints"`
+	Surplus        float64 `json:"surplus"`
+	Grade          string  `json:"grade"` // A to F by surplus relative to the other managers
+	Steals         int     `json:"steals"`
+	Busts          int     `json:"busts"`
+	BestPick       string  `json:"bestPick"`
+	WorstPick      string  `json:"worstPick"`
+}
+
+type DraftAnalysis struct {
+	LeagueKey string              `json:"leagueKey"`
+	Managers  []ManagerDraftGrade `json:"managers"` // Best grade first
+	Steals    []GradedPick        `json:"steals"`   // Largest surplus first
+	Busts     []GradedPick        `json:"busts"`    // Largest deficit first
+	Picks     []GradedPick        `json:"picks"`    // Draft order
+}
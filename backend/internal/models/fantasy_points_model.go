@@ -0,0 +1,32 @@
+package models
+
+type GamePoints struct {
+	GameID         string             `json:"gameId"`
+	GameDate       string             `json:"gameDate"`
+	Opponent       string             `json:"opponent"`
+	HomeRoad       string             `json:"homeRoad"`
+	Points         float64            `json:"points"`
+	RollingAverage float64            `json:"rollingAverage"` // Average over the window ending with this game
+	Breakdown      map[string]float64 `json:"breakdown"`      // Points by stat name
+}
+
+type PointsStreak struct {
+	Type          string  `json:"type"` // "hot" or "cold"
+	StartDate     string  `json:"startDate"`
+	EndDate       string  `json:"endDate"`
+	Games         int     `json:"games"`
+	AveragePoints float64 `json:"averagePoints"`
+}
+
+type PlayerPointsSeries struct {
+	PlayerKey     string         `json:"playerKey"`
+	NHLPlayerID   string         `json:"nhlPlayerId"`
+	Season        string         `json:"season"`
+	Window        int            `json:"window"`
+	TotalPoints   float64        `json:"totalPoints"`
+	SeasonAverage float64        `json:"seasonAverage"`
+	RecentAverage float64        `json:"recentAverage"`
+	CurrentForm   string         `json:"currentForm"` // "hot", "cold" or "neutral"
+	Games         []GamePoints   `json:"games"`
+	Streaks       []PointsStreak `json:"streaks"`
+}
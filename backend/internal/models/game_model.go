@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// Game statuses derived from the NHL game and schedule states
+const (
+	GameStatusScheduled = "scheduled"
+	GameStatusLive      = "live"
+	GameStatusFinal     = "final"
+	GameStatusPostponed = "postponed"
+)
+
+// LiveGame is the latest scoreboard state of an NHL game
+type LiveGame struct {
+	ID             int64     `gorm:"primaryKey" json:"id"`
+	GameDate       string    `json:"gameDate"`
+	StartTimeUTC   time.Time `json:"startTimeUTC"`
+	HomeTeamAbbrev string    `json:"homeTeam"`
+	AwayTeamAbbrev string    `json:"awayTeam"`
+	Status         string    `json:"status"`    // scheduled, live, final or postponed
+	GameState      string    `json:"gameState"` // NHL state: FUT, PRE, LIVE, CRIT, FINAL or OFF
+	Period         int       `json:"period"`
+	PeriodType     string    `json:"periodType"` // REG, OT or SO
+	Clock          string    `json:"clock"`      // Time remaining in the period
+	InIntermission bool      `json:"inIntermission"`
+	HomeScore      int       `json:"homeScore"`
+	AwayScore      int       `json:"awayScore"`
+	HomeShots      int       `json:"homeShots"`
+	AwayShots      int       `json:"awayShots"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func (LiveGame) TableName() string {
+	return "games"
+}
+
+type TodayPlayer struct {
+	PlayerKey        string          `json:"playerKey"`
+	NHLPlayerID      string          `json:"nhlPlayerId,omitempty"`
+	Name             string          `json:"name"`
+	Team             string          `json:"team"`
+	SelectedPosition string          `json:"selectedPosition"`
+	IsStarting       bool            `json:"isStarting"`
+	GameID           int64           `json:"gameId"`
+	Opponent         string          `json:"opponent"` // "vs MTL" or "@ MTL"
+	Status           string          `json:"status"`
+	Period           int             `json:"period"`
+	Clock            string          `json:"clock"`
+	Score            string          `json:"score"` // Player's team first, e.g. "3-2"
+	StartTimeUTC     time.Time       `json:"startTimeUTC"`
+	StatLine         *PlayerGameStat `json:"statLine,omitempty"` // Present once the game has started
+	FantasyPoints    float64         `json:"fantasyPoints"`
+}
+
+type TeamToday struct {
+	TeamKey       string        `json:"teamKey"`
+	Date          string        `json:"date"`
+	Playing       []TodayPlayer `json:"playing"`
+	NotPlaying    []string      `json:"notPlaying"` // Rostered players whose team is off
+	StartersLive  int           `json:"startersLive"`
+	StartersLeft  int           `json:"startersLeft"`  // Starters whose game has not started
+	StarterPoints float64       `json:"starterPoints"` // Fantasy points from players in scoring slots
+}
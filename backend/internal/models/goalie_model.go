@@ -0,0 +1,33 @@
+package models
+
+type GoalieStartProjection struct {
+	Date            string  `json:"date"`
+	Opponent        string  `json:"opponent"`
+	BackToBack      bool    `json:"backToBack"` // Second night of a back-to-back for the team
+	StartLikelihood float64 `json:"startLikelihood"`
+	ProjectedPoints float64 `json:"projectedPoints"`
+}
+
+type GoalieOutlook struct {
+	PlayerKey        string                  `json:"playerKey"`
+	NHLPlayerID      string                  `json:"nhlPlayerId"`
+	Team             string                  `json:"team"`
+	GamesPlayed      int                     `json:"gamesPlayed"`
+	Starts           int                     `json:"starts"`
+	Wins             int                     `json:"wins"`
+	Losses           int                     `json:"losses"`
+	OvertimeLosses   int                     `json:"overtimeLosses"`
+	Shutouts         int                     `json:"shutouts"`
+	Saves            int                     `json:"saves"`
+	GoalsAgainst     int                     `json:"goalsAgainst"`
+	SavePct          float64                 `json:"savePct"`
+	RecentTeamGames  int                     `json:"recentTeamGames"`
+	RecentStarts     int                     `json:"recentStarts"`
+	StartShare       float64                 `json:"startShare"` // Share of the team's recent games started
+	ConsecutiveStart int                     `json:"consecutiveStarts"`
+	PerStart         []ProjectedStat         `json:"perStart"`
+	PointsPerStart   float64                 `json:"pointsPerStart"`
+	Upcoming         []GoalieStartProjection `json:"upcoming"`
+	ExpectedStarts   float64                 `json:"expectedStarts"`
+	ProjectedPoints  float64                 `json:"projectedPoints"`
+}
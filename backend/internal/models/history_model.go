@@ -0,0 +1,104 @@
+package models
+
+import "time"
+
+// LeagueSeason is one archived season of a league chain. Yahoo issues a new league key each
+// season and links the seasons through their renew and renewed keys.
+type LeagueSeason struct {
+	LeagueKey         string           `gorm:"primaryKey;column:league_key" json:"leagueKey"`
+	Season            string           `gorm:"column:season" json:"season"`
+	Name              string           `gorm:"column:name" json:"name"`
+	NumTeams          int              `gorm:"column:num_teams" json:"numTeams"`
+	ScoringType       string           `gorm:"column:scoring_type" json:"scoringType"`
+	StartWeek         int              `gorm:"column:start_week" json:"startWeek"`
+	EndWeek           int              `gorm:"column:end_week" json:"endWeek"`
+	CurrentWeek       int              `gorm:"column:current_week" json:"currentWeek"`
+	PreviousLeagueKey string           `gorm:"column:previous_league_key" json:"previousLeagueKey,omitempty"`
+	NextLeagueKey     string           `gorm:"column:next_league_key" json:"nextLeagueKey,omitempty"`
+	IsFinished        bool             `gorm:"column:is_finished" json:"isFinished"`
+	ChampionTeamKey   string           `gorm:"column:champion_team_key" json:"championTeamKey,omitempty"`
+	RosterPositions   []RosterPosition `gorm:"column:roster_positions;serializer:json" json:"rosterPositions"`
+	StatModifiers     []StatModifier   `gorm:"column:stat_modifiers;serializer:json" json:"statModifiers"`
+	ArchivedAt        time.Time        `gorm:"column:archived_at;autoUpdateTime" json:"archivedAt"`
+}
+
+// SeasonTeam is a team's final standing in an archived season and the manager who ran it
+type SeasonTeam struct {
+	LeagueKey     string  `gorm:"primaryKey;column:league_key" json:"leagueKey"`
+	TeamKey       string  `gorm:"primaryKey;column:team_key" json:"teamKey"`
+	Season        string  `gorm:"column:season" json:"season"`
+	Name          string  `gorm:"column:name" json:"name"`
+	ManagerID     string  `gorm:"column:manager_id" json:"managerId"` // Yahoo manager guid, stable across seasons
+	ManagerName   string  `gorm:"column:manager_name" json:"managerName"`
+	Rank          int     `gorm:"column:rank" json:"rank"`
+	PlayoffSeed   int     `gorm:"column:playoff_seed" json:"playoffSeed"`
+	Wins          int     `gorm:"column:wins" json:"wins"`
+	Losses        int     `gorm:"column:losses" json:"losses"`
+	Ties          int     `gorm:"column:ties" json:"ties"`
+	PointsFor     float64 `gorm:"column:points_for" json:"pointsFor"`
+	PointsAgainst float64 `gorm:"column:points_against" json:"pointsAgainst"`
+}
+
+// SeasonMatchup is a finished head-to-head matchup of an archived season
+type SeasonMatchup struct {
+	LeagueKey      string  `gorm:"primaryKey;column:league_key" json:"leagueKey"`
+	Week           int     `gorm:"primaryKey;column:week" json:"week"`
+	TeamKey        string  `gorm:"primaryKey;column:team_key" json:"teamKey"`
+	OpponentKey    string  `gorm:"column:opponent_key" json:"opponentKey"`
+	TeamPoints     float64 `gorm:"column:team_points" json:"teamPoints"`
+	OpponentPoints float64 `gorm:"column:opponent_points" json:"opponentPoints"`
+	WinnerTeamKey  string  `gorm:"column:winner_team_key" json:"winnerTeamKey,omitempty"`
+	IsTied         bool    `gorm:"column:is_tied" json:"isTied"`
+	IsPlayoffs     bool    `gorm:"column:is_playoffs" json:"isPlayoffs"`
+	IsConsolation  bool    `gorm:"column:is_consolation" json:"isConsolation"`
+}
+
+type SeasonSummary struct {
+	Season          string `json:"season"`
+	LeagueKey       string `json:"leagueKey"`
+	Name            string `json:"name"`
+	NumTeams        int    `json:"numTeams"`
+	IsFinished      bool   `json:"isFinished"`
+	ChampionTeam    string `json:"championTeam,omitempty"`
+	ChampionManager string `json:"championManager,omitempty"`
+}
+
+type ManagerCareer struct {
+	ManagerID          string   `json:"managerId"`
+	ManagerName        string   `json:"managerName"`
+	Seasons            int      `json:"seasons"`
+	Championships      int      `json:"championships"`
+	PlayoffAppearances int      `json:"playoffAppearances"`
+	Wins               int      `json:"wins"` // Regular season
+	Losses             int      `json:"losses"`
+	Ties               int      `json:"ties"`
+	WinPercentage      float64  `json:"winPercentage"`
+	PlayoffWins        int      `json:"playoffWins"`
+	PlayoffLosses      int      `json:"playoffLosses"`
+	PointsFor          float64  `json:"pointsFor"`
+	PointsAgainst      float64  `json:"pointsAgainst"`
+	BestFinish         int      `json:"bestFinish"`
+	AverageFinish      float64  `json:"averageFinish"`
+	TeamNames          []string `json:"teamNames"`
+}
+
+type HeadToHeadRecord struct {
+	ManagerID       string  `json:"managerId"`
+	ManagerName     string  `json:"managerName"`
+	OpponentID      string  `json:"opponentId"`
+	OpponentName    string  `json:"opponentName"`
+	Meetings        int     `json:"meetings"`
+	Wins            int     `json:"wins"`
+	Losses          int     `json:"losses"`
+	Ties            int     `json:"ties"`
+	PointsFor       float64 `json:"pointsFor"`
+	PointsAgainst   float64 `json:"pointsAgainst"`
+	PlayoffMeetings int     `json:"playoffMeetings"`
+}
+
+type LeagueHistory struct {
+	LeagueKey  string             `json:"leagueKey"`
+	Seasons    []SeasonSummary    `json:"seasons"`    // Newest first
+	Managers   []ManagerCareer    `json:"managers"`   // Most championships, then best win percentage
+	HeadToHead []HeadToHeadRecord `json:"headToHead"` // Consolation games are left out
+}
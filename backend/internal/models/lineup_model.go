@@ -0,0 +1,38 @@
+package models
+
+type LineupSlot struct {
+	Position        string  `json:"position"`
+	PlayerKey       string  `json:"playerKey,omitempty"` // Empty when no player with a game can fill the slot
+	Name            string  `json:"name,omitempty"`
+	Opponent        string  `json:"opponent,omitempty"`
+	ProjectedPoints float64 `json:"projectedPoints"`
+}
+
+type BenchDecision struct {
+	PlayerKey         string   `json:"playerKey"`
+	Name              string   `json:"name"`
+	EligiblePositions []string `json:"eligiblePositions"`
+	HasGame           bool     `json:"hasGame"`
+	ProjectedPoints   float64  `json:"projectedPoints"`
+	Reason            string   `json:"reason"`
+}
+
+type DailyLineup struct {
+	Date            string          `json:"date"`
+	Starters        []LineupSlot    `json:"starters"`
+	Bench           []BenchDecision `json:"bench"`
+	ProjectedPoints float64         `json:"projectedPoints"`
+	CurrentPoints   float64         `json:"currentPoints"` // Projection for the lineup as currently set
+	Start           []string        `json:"start"`         // Benched players the optimal lineup starts
+	Sit             []string        `json:"sit"`           // Starters with a game the optimal lineup benches
+}
+
+type LineupOptimization struct {
+	TeamKey         string        `json:"teamKey"`
+	StartDate       string        `json:"startDate"`
+	EndDate         string        `json:"endDate"`
+	ProjectedPoints float64       `json:"projectedPoints"`
+	CurrentPoints   float64       `json:"currentPoints"`
+	Gain            float64       `json:"gain"`
+	Days            []DailyLineup `json:"days"`
+}
@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+type MockDraft struct {
+	ID              uint             `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	LeagueKey       string           `gorm:"column:league_key" json:"leagueKey"`
+	Teams           int              `gorm:"column:teams" json:"teams"`
+	Rounds          int              `gorm:"column:rounds" json:"rounds"`
+	DraftPosition   int              `gorm:"column:draft_position" json:"draftPosition"` // The user's slot in the first round
+	RosterPositions []RosterPosition `gorm:"column:roster_positions;serializer:json" json:"rosterPositions"`
+	CreatedAt       time.Time        `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt       time.Time        `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+// MockDraftPlayer is a player in a mock draft's pool, ranked when the draft was created
+type MockDraftPlayer struct {
+	DraftID           uint     `gorm:"primaryKey;column:draft_id" json:"-"`
+	PlayerKey         string   `gorm:"primaryKey;column:player_key" json:"playerKey"`
+	Rank              int      `gorm:"column:rank" json:"rank"`
+	Name              string   `gorm:"column:name" json:"name"`
+	Team              string   `gorm:"column:team" json:"team"`
+	EligiblePositions []string `gorm:"column:eligible_positions;serializer:json" json:"eligiblePositions"`
+	PositionType      string   `gorm:"column:position_type" json:"positionType"`
+	PointsPerGame     float64  `gorm:"column:points_per_game" json:"pointsPerGame"`
+	ProjectedPoints   float64  `gorm:"column:projected_points" json:"projectedPoints"` // Over a full season
+}
+
+type MockDraftPick struct {
+	DraftID   uint   `gorm:"primaryKey;column:draft_id" json:"-"`
+	Pick      int    `gorm:"primaryKey;column:pick" json:"pick"`
+	Round     int    `gorm:"column:round" json:"round"`
+	TeamSlot  int    `gorm:"column:team_slot" json:"teamSlot"`
+	PlayerKey string `gorm:"column:player_key" json:"playerKey"`
+	Name      string `gorm:"-" json:"name"`
+}
+
+type MockDraftRequest struct {
+	DraftPosition int `json:"draftPosition"`
+	Rounds        int `json:"rounds"` // Defaults to the league's active roster size
+}
+
+type MockDraftPickRequest struct {
+	PlayerKey string `json:"playerKey"`
+}
+
+type DraftRecommendation struct {
+	PlayerKey         string   `json:"playerKey"`
+	Name              string   `json:"name"`
+	EligiblePositions []string `json:"eligiblePositions"`
+	Rank              int      `json:"rank"`
+	ProjectedPoints   float64  `json:"projectedPoints"`
+	Slot              string   `json:"slot"`    // Roster slot the player would fill
+	DropOff           float64  `json:"dropOff"` // Points over the best player likely left at that slot next turn
+	Score             float64  `json:"score"`
+	Reason            string   `json:"reason"`
+}
+
+type MockDraftState struct {
+	Draft           MockDraft             `json:"draft"`
+	CurrentPick     int                   `json:"currentPick"`
+	Round           int                   `json:"round"`
+	TeamSlot        int                   `json:"teamSlot"` // Slot on the clock
+	IsUserPick      bool                  `json:"isUserPick"`
+	PicksUntilUser  int                   `json:"picksUntilUser"`
+	Complete        bool                  `json:"complete"`
+	UserRoster      []MockDraftPlayer     `json:"userRoster"`
+	OpenSlots       map[string]int        `json:"openSlots"`
+	Picks           []MockDraftPick       `json:"picks"`
+	Recommendations []DraftRecommendation `json:"recommendations"`
+}
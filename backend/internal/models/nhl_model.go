@@ -2,6 +2,12 @@ package models
 
 import "time"
 
+// NHL game types as used by the schedule and game log endpoints
+const (
+	RegularSeasonGame = 2
+	PlayoffGame       = 3
+)
+
 type ScheduleGame struct {
 	ID             int64     `json:"id"`
 	Season         int       `json:"season"`
@@ -10,6 +16,32 @@ type ScheduleGame struct {
 	StartTimeUTC   time.Time `json:"startTimeUTC"` // Use time.Time for compatibility
 	HomeTeamAbbrev string    `json:"homeTeam"`
 	AwayTeamAbbrev string    `json:"awayTeam"`
+	GameState      string    `json:"gameState"`         // FUT, PRE, LIVE, CRIT, FINAL or OFF
+	ScheduleState  string    `json:"gameScheduleState"` // OK, PPD (postponed), SUSP, CNCL or TBD
+	HomeScore      int       `json:"homeScore"`
+	AwayScore      int       `json:"awayScore"`
+	LastPeriodType string    `json:"lastPeriodType"` // REG, OT or SO once the game is over
+	Rescheduled    bool      `json:"rescheduled"`    // Date or start time moved since the game was first synced
+}
+
+// Schedule change types
+const (
+	ScheduleChangeRescheduled = "rescheduled"
+	ScheduleChangeStatus      = "status"
+	ScheduleChangeFinal       = "final"
+)
+
+// ScheduleChange records a difference found between a stored game and a schedule sync
+type ScheduleChange struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	GameID         int64     `json:"gameId"`
+	GameDate       string    `json:"gameDate"` // Date after the change
+	HomeTeamAbbrev string    `json:"homeTeam"`
+	AwayTeamAbbrev string    `json:"awayTeam"`
+	ChangeType     string    `json:"changeType"`
+	OldValue       string    `json:"oldValue"`
+	NewValue       string    `json:"newValue"`
+	DetectedAt     time.Time `json:"detectedAt"`
 }
 
 type PlayerIDMapping struct {
@@ -45,8 +77,10 @@ type NHLPlayer struct {
 }
 
 type PlayerGameStat struct {
-	GameID            string `json:"gameId"`
-	PlayerID          string `json:"playerId"`
+	GameID            string `gorm:"primaryKey" json:"gameId"`
+	PlayerID          string `gorm:"primaryKey" json:"playerId"`
+	Season            int    `json:"season"`
+	GameType          int    `json:"gameType"` // RegularSeasonGame or PlayoffGame
 	TeamAbbrev        string `json:"teamAbbrev"`
 	HomeRoadFlag      string `json:"homeRoadFlag"`
 	GameDate          string `json:"gameDate"`
@@ -67,4 +101,13 @@ type PlayerGameStat struct {
 	OpponentAbbrev    string `json:"opponentAbbrev"`
 	PIM               int    `json:"pim"`
 	TOI               string `json:"toi"`
+
+	// Goalie fields, zero for skaters
+	GamesStarted int     `json:"gamesStarted"`
+	Decision     string  `json:"decision"` // W, L or O
+	ShotsAgainst int     `json:"shotsAgainst"`
+	GoalsAgainst int     `json:"goalsAgainst"`
+	Saves        int     `json:"saves"`
+	SavePctg     float64 `json:"savePctg"`
+	Shutouts     int     `json:"shutouts"`
 }
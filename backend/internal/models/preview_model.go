@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type PreviewSide struct {
+	TeamKey              string  `json:"teamKey"`
+	Name                 string  `json:"name"`
+	ActualPoints         float64 `json:"actualPoints"`         // Scored so far this week
+	RemainingPoints      float64 `json:"remainingPoints"`      // Projected from optimal lineups over the games left
+	ProjectedPoints      float64 `json:"projectedPoints"`      // Actual plus remaining
+	RemainingStarts      int     `json:"remainingStarts"`      // Player games the optimal lineups start
+	YahooProjectedPoints float64 `json:"yahooProjectedPoints"` // Yahoo's own projection for comparison
+}
+
+type PreviewCategory struct {
+	StatID            string  `json:"statId"`
+	Name              string  `json:"name"`
+	LowerIsBetter     bool    `json:"lowerIsBetter"`
+	Projected         bool    `json:"projected"` // Rate stats cannot be summed and keep their current value
+	TeamActual        float64 `json:"teamActual"`
+	OpponentActual    float64 `json:"opponentActual"`
+	TeamProjected     float64 `json:"teamProjected"`
+	OpponentProjected float64 `json:"opponentProjected"`
+	WinProbability    float64 `json:"winProbability"`
+}
+
+type MatchupPreview struct {
+	LeagueKey              string            `json:"leagueKey"`
+	Week                   int               `json:"week"`
+	Status                 string            `json:"status"` // preevent, midevent or postevent
+	WeekStart              string            `json:"weekStart"`
+	WeekEnd                string            `json:"weekEnd"`
+	ProjectedFrom          string            `json:"projectedFrom"` // Games starting after this time are projected
+	ScoringType            string            `json:"scoringType"`   // points or categories
+	Simulations            int               `json:"simulations"`
+	Team                   PreviewSide       `json:"team"`
+	Opponent               PreviewSide       `json:"opponent"`
+	Categories             []PreviewCategory `json:"categories"`
+	ExpectedCategoryWins   float64           `json:"expectedCategoryWins"`
+	ExpectedCategoryLosses float64           `json:"expectedCategoryLosses"`
+	WinProbability         float64           `json:"winProbability"`
+	TieProbability         float64           `json:"tieProbability"`
+	LossProbability        float64           `json:"lossProbability"`
+	GeneratedAt            time.Time         `json:"generatedAt"`
+}
@@ -0,0 +1,43 @@
+package models
+
+type ProjectedStat struct {
+	StatID       string  `json:"statId"`
+	Name         string  `json:"name"`
+	PerGame      float64 `json:"perGame"`
+	RestOfSeason float64 `json:"restOfSeason"`
+}
+
+type PlayerProjection struct {
+	PlayerKey          string          `json:"playerKey,omitempty"`
+	NHLPlayerID        string          `json:"nhlPlayerId"`
+	Team               string          `json:"team"`
+	GamesSampled       int             `json:"gamesSampled"`
+	AverageTOI         float64         `json:"averageToi"` // Weighted minutes per game
+	RecentTOI          float64         `json:"recentToi"`
+	TOIFactor          float64         `json:"toiFactor"`       // Applied to even-strength scoring rates
+	PowerPlayFactor    float64         `json:"powerPlayFactor"` // Applied to power-play rates
+	BaselineGames      int             `json:"baselineGames"`   // Regular season games from prior seasons
+	BaselineWeight     float64         `json:"baselineWeight"`  // Share of each rate taken from prior seasons
+	Stats              []ProjectedStat `json:"stats"`
+	PointsPerGame      float64         `json:"pointsPerGame"`
+	RemainingGames     int             `json:"remainingGames"`
+	RestOfSeasonPoints float64         `json:"restOfSeasonPoints"`
+}
+
+type PlayerBacktest struct {
+	NHLPlayerID     string  `json:"nhlPlayerId"`
+	GamesBefore     int     `json:"gamesBefore"`
+	GamesAfter      int     `json:"gamesAfter"`
+	ProjectedPoints float64 `json:"projectedPoints"` // Projected rate times the games actually played
+	ActualPoints    float64 `json:"actualPoints"`
+	Error           float64 `json:"error"` // Projected minus actual
+}
+
+type ProjectionBacktest struct {
+	AsOf                 string           `json:"asOf"`
+	Players              int              `json:"players"`
+	MeanAbsoluteError    float64          `json:"meanAbsoluteError"` // Per player, in points
+	MeanError            float64          `json:"meanError"`         // Positive when projections run high
+	PerGameAbsoluteError float64          `json:"perGameAbsoluteError"`
+	Results              []PlayerBacktest `json:"results"` // Largest misses first
+}
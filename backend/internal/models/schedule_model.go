@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+type ScheduleNight struct {
+	Date  string `json:"date"`
+	Games int    `json:"games"`
+	Light bool   `json:"light"` // Few games, so streamers rarely compete for lineup slots
+	Heavy bool   `json:"heavy"`
+}
+
+type NHLTeamWeekSchedule struct {
+	Team            string   `json:"team"`
+	Games           int      `json:"games"`
+	GameDates       []string `json:"gameDates"`
+	BackToBacks     int      `json:"backToBacks"`
+	LightNightGames int      `json:"lightNightGames"`
+}
+
+type WeekSchedule struct {
+	Week      int                   `json:"week"`
+	StartDate string                `json:"startDate"`
+	EndDate   string                `json:"endDate"`
+	Nights    []ScheduleNight       `json:"nights"`
+	Teams     []NHLTeamWeekSchedule `json:"teams"` // Most games first
+}
+
+type TeamGameDay struct {
+	Date      string `json:"date"`
+	Games     int    `json:"games"`     // Rostered players with a game
+	Usable    int    `json:"usable"`    // Games that fit in a starting slot
+	OpenSlots int    `json:"openSlots"` // Starting slots left empty
+}
+
+type PlayerGamesRemaining struct {
+	PlayerKey string `json:"playerKey"`
+	Name      string `json:"name"`
+	Team      string `json:"team"`
+	Games     int    `json:"games"`
+}
+
+type TeamGamesRemaining struct {
+	TeamKey     string                 `json:"teamKey"`
+	Week        int                    `json:"week"`
+	StartDate   string                 `json:"startDate"`
+	EndDate     string                 `json:"endDate"`
+	FromDate    string                 `json:"fromDate"`
+	Games       int                    `json:"games"`
+	UsableGames int                    `json:"usableGames"`
+	WastedGames int                    `json:"wastedGames"` // Games lost to full lineups
+	OpenSlots   int                    `json:"openSlots"`   // Empty starting slot-days, the room for streamers
+	Days        []TeamGameDay          `json:"days"`
+	Players     []PlayerGamesRemaining `json:"players"`
+}
+
+// GameWeek is a fantasy week as Yahoo defines it for a game. Weeks are irregular: the opening week
+// is extended and weeks around breaks are merged.
+type GameWeek struct {
+	Week  int       `json:"week"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
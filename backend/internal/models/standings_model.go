@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+type TeamStanding struct {
+	LeagueID      string    `gorm:"column:league_id;primaryKey" json:"leagueId"`
+	TeamKey       string    `gorm:"column:team_key;primaryKey" json:"teamKey"`
+	Week          int       `gorm:"column:week;primaryKey" json:"week"`
+	Name          string    `gorm:"column:name" json:"name"`
+	Rank          int       `gorm:"column:rank" json:"rank"`
+	PlayoffSeed   int       `gorm:"column:playoff_seed" json:"playoffSeed"`
+	Wins          int       `gorm:"column:wins" json:"wins"`
+	Losses        int       `gorm:"column:losses" json:"losses"`
+	Ties          int       `gorm:"column:ties" json:"ties"`
+	Percentage    float64   `gorm:"column:percentage" json:"percentage"`
+	PointsFor     float64   `gorm:"column:points_for" json:"pointsFor"`
+	PointsAgainst float64   `gorm:"column:points_against" json:"pointsAgainst"`
+	StreakType    string    `gorm:"column:streak_type" json:"streakType"`
+	StreakValue   int       `gorm:"column:streak_value" json:"streakValue"`
+	LastUpdated   time.Time `gorm:"autoUpdateTime" json:"lastUpdated"`
+}
+
+type PowerRanking struct {
+	TeamKey            string  `json:"teamKey"`
+	Name               string  `json:"name"`
+	Rank               int     `json:"rank"`
+	PowerScore         float64 `json:"powerScore"`
+	Wins               int     `json:"wins"`
+	Losses             int     `json:"losses"`
+	Ties               int     `json:"ties"`
+	AllPlayWins        int     `json:"allPlayWins"`
+	AllPlayLosses      int     `json:"allPlayLosses"`
+	AllPlayTies        int     `json:"allPlayTies"`
+	AllPlayPercentage  float64 `json:"allPlayPercentage"`
+	PointsFor          float64 `json:"pointsFor"`
+	PointsAgainst      float64 `json:"pointsAgainst"`
+	StrengthOfSchedule float64 `json:"strengthOfSchedule"` // Average all-play percentage of opponents faced
+}
+
+type LeagueStandingsResponse struct {
+	LeagueID      string         `json:"leagueId"`
+	Week          int            `json:"week"`
+	Standings     []TeamStanding `json:"standings"`
+	PowerRankings []PowerRanking `json:"powerRankings"`
+}
+
+type WeeklyLuck struct {
+	Week          int     `json:"week"`
+	Points        float64 `json:"points"`
+	Opponent      string  `json:"opponent"`
+	Won           bool    `json:"won"`
+	Tied          bool    `json:"tied"`
+	AllPlayWins   int     `json:"allPlayWins"`
+	AllPlayLosses int     `json:"allPlayLosses"`
+	AllPlayTies   int     `json:"allPlayTies"`
+	ExpectedWins  float64 `json:"expectedWins"` // Share of the league this score would have beaten
+	Luck          float64 `json:"luck"`         // Actual result minus expected wins
+}
+
+type TeamLuck struct {
+	TeamKey       string       `json:"teamKey"`
+	Name          string       `json:"name"`
+	Wins          int          `json:"wins"`
+	Losses        int          `json:"losses"`
+	Ties          int          `json:"ties"`
+	AllPlayWins   int          `json:"allPlayWins"`
+	AllPlayLosses int          `json:"allPlayLosses"`
+	AllPlayTies   int          `json:"allPlayTies"`
+	ExpectedWins  float64      `json:"expectedWins"`
+	LuckIndex     float64      `json:"luckIndex"` // Actual wins, with ties as half, minus expected wins
+	Weeks         []WeeklyLuck `json:"weeks"`
+}
+
+type LeagueLuckResponse struct {
+	LeagueID string     `json:"leagueId"`
+	Teams    []TeamLuck `json:"teams"`
+}
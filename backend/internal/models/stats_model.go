@@ -4,7 +4,32 @@ type FantasyPlayerStats struct {
 	Stats []StatModifier `gorm:"-"`
 }
 
+type PlayerContribution struct {
+	PlayerKey        string  `json:"playerKey"`
+	Name             string  `json:"name"`
+	SelectedPosition string  `json:"selectedPosition,omitempty"`
+	Points           float64 `json:"points"`
+	Share            float64 `json:"share"` // Fraction of the team's actual points
+}
+
+type ProjectedVsActualWeek struct {
+	Week            int                  `json:"week"`
+	ProjectedPoints float64              `json:"projectedPoints"`
+	ActualPoints    float64              `json:"actualPoints"`
+	Delta           float64              `json:"delta"`           // Actual minus projected
+	CumulativeDelta float64              `json:"cumulativeDelta"` // Running total of deltas through this week
+	InProgress      bool                 `json:"inProgress"`
+	Players         []PlayerContribution `json:"players"`
+}
+
 type ProjectedVsActualStats struct {
-	ProjectedStats string
-	ActualStats    string
+	TeamKey        string                  `json:"teamKey"`
+	TotalProjected float64                 `json:"totalProjected"`
+	TotalActual    float64                 `json:"totalActual"`
+	TotalDelta     float64                 `json:"totalDelta"`
+	AverageDelta   float64                 `json:"averageDelta"`
+	WeeksOver      int                     `json:"weeksOver"`
+	WeeksUnder     int                     `json:"weeksUnder"`
+	Weeks          []ProjectedVsActualWeek `json:"weeks"`
+	SeasonPlayers  []PlayerContribution    `json:"seasonPlayers"` // Contributions summed over completed weeks
 }
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// PlayerStatus is the latest Yahoo status seen for a player
+type PlayerStatus struct {
+	PlayerKey   string    `gorm:"primaryKey;column:player_key" json:"playerKey"`
+	PlayerName  string    `gorm:"column:player_name" json:"playerName"`
+	Status      string    `gorm:"column:status" json:"status"` // Empty when healthy
+	StatusFull  string    `gorm:"column:status_full" json:"statusFull"`
+	InjuryNote  string    `gorm:"column:injury_note" json:"injuryNote"`
+	StatusSince time.Time `gorm:"column:status_since" json:"statusSince"` // First fetch showing the current status
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+type PlayerStatusChange struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	PlayerKey      string    `gorm:"column:player_key" json:"playerKey"`
+	PlayerName     string    `gorm:"column:player_name" json:"playerName"`
+	PreviousStatus string    `gorm:"column:previous_status" json:"previousStatus"`
+	Status         string    `gorm:"column:status" json:"status"`
+	StatusFull     string    `gorm:"column:status_full" json:"statusFull"`
+	InjuryNote     string    `gorm:"column:injury_note" json:"injuryNote"`
+	ChangedAt      time.Time `gorm:"column:changed_at" json:"changedAt"`
+}
+
+type InjuredPlayer struct {
+	PlayerKey        string    `json:"playerKey"`
+	Name             string    `json:"name"`
+	Team             string    `json:"team"`
+	SelectedPosition string    `json:"selectedPosition"`
+	IsStarting       bool      `json:"isStarting"`
+	Status           string    `json:"status"`
+	StatusFull       string    `json:"statusFull"`
+	InjuryNote       string    `json:"injuryNote"`
+	RuledOut         bool      `json:"ruledOut"` // Lineup tools leave the player out
+	Since            time.Time `json:"since"`
+}
+
+type TeamInjuries struct {
+	TeamKey          string          `json:"teamKey"`
+	Players          []InjuredPlayer `json:"players"`
+	RuledOutStarters int             `json:"ruledOutStarters"` // Ruled out players sitting in scoring slots
+}
@@ -0,0 +1,55 @@
+package models
+
+type TradeAnalysisRequest struct {
+	TeamA      string   `json:"teamA"`
+	TeamB      string   `json:"teamB"`
+	TeamAGives []string `json:"teamAGives"` // Player keys moving from team A to team B
+	TeamBGives []string `json:"teamBGives"`
+	Window     string   `json:"window,omitempty"` // Scoring window for the per-game rate, last30 by default
+}
+
+type TradePlayerProjection struct {
+	PlayerKey          string   `json:"playerKey"`
+	Name               string   `json:"name"`
+	Team               string   `json:"team"`
+	EligiblePositions  []string `json:"eligiblePositions"`
+	PointsPerGame      float64  `json:"pointsPerGame"`
+	RateSource         string   `json:"rateSource"` // "season" or "gameLog"
+	RemainingGames     int      `json:"remainingGames"`
+	RestOfSeasonPoints float64  `json:"restOfSeasonPoints"`
+}
+
+type CategoryChange struct {
+	StatID string  `json:"statId"`
+	Name   string  `json:"name"`
+	Before float64 `json:"before"` // Projected rest-of-season total
+	After  float64 `json:"after"`
+	Change float64 `json:"change"`
+}
+
+type TradeSide struct {
+	TeamKey               string                  `json:"teamKey"`
+	Gives                 []TradePlayerProjection `json:"gives"`
+	Receives              []TradePlayerProjection `json:"receives"`
+	PointsGiven           float64                 `json:"pointsGiven"`
+	PointsReceived        float64                 `json:"pointsReceived"`
+	NetRestOfSeasonPoints float64                 `json:"netRestOfSeasonPoints"`
+	WeeklyPointsBefore    float64                 `json:"weeklyPointsBefore"` // Optimal lineup points per remaining week
+	WeeklyPointsAfter     float64                 `json:"weeklyPointsAfter"`
+	WeeklyPointsChange    float64                 `json:"weeklyPointsChange"`
+	OpenSlotsBefore       int                     `json:"openSlotsBefore"` // Empty starting slot-days for the rest of the season
+	OpenSlotsAfter        int                     `json:"openSlotsAfter"`
+	ExcessPlayers         int                     `json:"excessPlayers"` // Players to drop to fit the roster
+	Categories            []CategoryChange        `json:"categories"`
+}
+
+type TradeAnalysis struct {
+	LeagueKey      string    `json:"leagueKey"`
+	Window         string    `json:"window"`
+	FromDate       string    `json:"fromDate"`
+	ToDate         string    `json:"toDate"`
+	WeeksRemaining float64   `json:"weeksRemaining"`
+	TeamA          TradeSide `json:"teamA"`
+	TeamB          TradeSide `json:"teamB"`
+	Favors         string    `json:"favors,omitempty"` // Team key gaining more weekly points, empty when even
+}
@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+type Transaction struct {
+	TransactionKey string              `gorm:"primaryKey;column:transaction_key" json:"transactionKey"`
+	LeagueKey      string              `gorm:"column:league_key" json:"leagueKey"`
+	Type           string              `gorm:"column:type" json:"type"` // add, drop, add/drop or trade
+	Status         string              `gorm:"column:status" json:"status"`
+	Timestamp      time.Time           `gorm:"column:timestamp" json:"timestamp"`
+	FaabBid        int                 `gorm:"column:faab_bid" json:"faabBid"` // Zero unless a FAAB waiver claim
+	TraderTeamKey  string              `gorm:"column:trader_team_key" json:"traderTeamKey,omitempty"`
+	TradeeTeamKey  string              `gorm:"column:tradee_team_key" json:"tradeeTeamKey,omitempty"`
+	Players        []TransactionPlayer `gorm:"-" json:"players"`
+}
+
+type TransactionPlayer struct {
+	TransactionKey     string `gorm:"primaryKey;column:transaction_key" json:"-"`
+	PlayerKey          string `gorm:"primaryKey;column:player_key" json:"playerKey"`
+	PlayerName         string `gorm:"column:player_name" json:"playerName"`
+	TeamAbbreviation   string `gorm:"column:team_abbr" json:"teamAbbr"`
+	DisplayPosition    string `gorm:"column:display_position" json:"displayPosition"`
+	Type               string `gorm:"column:type" json:"type"`              // add, drop or trade
+	SourceType         string `gorm:"column:source_type" json:"sourceType"` // freeagents, waivers or team
+	SourceTeamKey      string `gorm:"column:source_team_key" json:"sourceTeamKey,omitempty"`
+	DestinationType    string `gorm:"column:destination_type" json:"destinationType"` // team, waivers or freeagents
+	DestinationTeamKey string `gorm:"column:destination_team_key" json:"destinationTeamKey,omitempty"`
+}
+
+type TransactionFilter struct {
+	Type      string // add, drop, add/drop, trade or waiver
+	TeamKey   string // Either side of the move
+	PlayerKey string
+	Since     string // YYYY-MM-DD, inclusive
+	Until     string // YYYY-MM-DD, inclusive
+}
+
+type PlayerMoveCount struct {
+	PlayerKey  string `json:"playerKey"`
+	PlayerName string `json:"playerName"`
+	Count      int    `json:"count"`
+}
+
+type ManagerActivity struct {
+	TeamKey      string  `json:"teamKey"`
+	TeamName     string  `json:"teamName"`
+	Adds         int     `json:"adds"`
+	Drops        int     `json:"drops"`
+	Trades       int     `json:"trades"`
+	WaiverClaims int     `json:"waiverClaims"`
+	FaabSpent    int     `json:"faabSpent"`
+	PickupPoints float64 `json:"pickupPoints"` // Fantasy points scored by added players while on the team
+}
+
+type PickupValue struct {
+	PlayerKey  string    `json:"playerKey"`
+	PlayerName string    `json:"playerName"`
+	TeamKey    string    `json:"teamKey"`
+	AddedAt    time.Time `json:"addedAt"`
+	DroppedAt  string    `json:"droppedAt,omitempty"` // YYYY-MM-DD, empty while still rostered
+	FaabBid    int       `json:"faabBid"`
+	Games      int       `json:"games"`
+	Points     float64   `json:"points"`
+}
+
+type TransactionAnalysis struct {
+	LeagueKey   string            `json:"leagueKey"`
+	MostAdded   []PlayerMoveCount `json:"mostAdded"`
+	MostDropped []PlayerMoveCount `json:"mostDropped"`
+	Managers    []ManagerActivity `json:"managers"` // Most active first
+	Pickups     []PickupValue     `json:"pickups"`  // Most points first
+}
@@ -0,0 +1,37 @@
+package models
+
+type DropCandidate struct {
+	PlayerKey       string  `json:"playerKey"`
+	Name            string  `json:"name"`
+	Position        string  `json:"position"` // Position shared with the free agent
+	PointsPerGame   float64 `json:"pointsPerGame"`
+	UpcomingGames   int     `json:"upcomingGames"`
+	ProjectedPoints float64 `json:"projectedPoints"`
+}
+
+type WaiverCandidate struct {
+	PlayerKey           string         `json:"playerKey"`
+	Name                string         `json:"name"`
+	Team                string         `json:"team"`
+	DisplayPosition     string         `json:"displayPosition"`
+	EligiblePositions   []string       `json:"eligiblePositions"`
+	SeasonPointsPerGame float64        `json:"seasonPointsPerGame"`
+	WindowPointsPerGame float64        `json:"windowPointsPerGame"`
+	WindowGames         int            `json:"windowGames"`
+	WindowSource        string         `json:"windowSource"`         // "season", "rank" or "gameLog"
+	WindowRank          int            `json:"windowRank,omitempty"` // Yahoo league rank over the window
+	UpcomingGames       int            `json:"upcomingGames"`
+	ProjectedPoints     float64        `json:"projectedPoints"` // Window points per game times upcoming games
+	Ranks               []PlayerRank   `json:"ranks"`
+	DropCandidate       *DropCandidate `json:"dropCandidate,omitempty"` // Weakest rostered player at a shared position
+	Gain                float64        `json:"gain"`
+	Recommended         bool           `json:"recommended"`
+}
+
+type WaiverRecommendations struct {
+	TeamKey    string            `json:"teamKey"`
+	Window     string            `json:"window"`
+	FromDate   string            `json:"fromDate"`
+	ToDate     string            `json:"toDate"`
+	Candidates []WaiverCandidate `json:"candidates"` // Largest gain first
+}
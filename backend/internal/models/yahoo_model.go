@@ -47,6 +47,15 @@ type RosterPosition struct {
 	IsStartingPosition bool   `gorm:"column:is_starting_position"`
 }
 
+type StatCategory struct {
+	StatID            string `gorm:"column:stat_id"`
+	Name              string `gorm:"column:name"`
+	DisplayName       string `gorm:"column:display_name"`
+	PositionType      string `gorm:"column:position_type"`
+	LowerIsBetter     bool   `gorm:"column:lower_is_better"`      // Yahoo sort_order 0
+	IsOnlyDisplayStat bool   `gorm:"column:is_only_display_stat"` // Shown but not scored
+}
+
 type StatModifier struct {
 	StatID   string  `gorm:"column:stat_id"`
 	Value    float64 `gorm:"column:value"`
@@ -80,6 +89,7 @@ type League struct {
 	Season                string           `gorm:"column:season"`
 	MaxTeams              int              `gorm:"column:max_teams"`
 	RosterPositions       []RosterPosition `gorm:"-"`
+	StatCategories        []StatCategory   `gorm:"-"`
 	StatModifiers         []StatModifier   `gorm:"-"`
 	LastUpdated           time.Time        `gorm:"autoUpdateTime"`
 }
@@ -124,6 +134,9 @@ type Player struct {
 	PlayerNotes        bool       `gorm:"column:player_notes"`         // Indicates if the player has notes
 	RecentNotes        bool       `gorm:"column:recent_notes"`         // Indicates if there are recent player notes
 	PlayerNotesUpdated int        `gorm:"column:player_notes_updated"` // Timestamp of the last notes update
+	Status             string     `gorm:"column:status"`               // Injury or availability status (e.g., IR, DTD, O), empty when healthy
+	StatusFull         string     `gorm:"column:status_full"`          // Status description (e.g., Day-To-Day)
+	InjuryNote         string     `gorm:"column:injury_note"`          // Injured body part or reason
 	Stats              []Stat     `gorm:"-"`                           // Regular stats
 	AdvancedStats      []Stat     `gorm:"-"`                           // Advanced stats
 	NextUpdate         time.Time  `gorm:"column:next_update"`
@@ -160,6 +173,9 @@ type YahooPlayer struct {
 	FullName    string `gorm:"column:full_name"`
 	TeamName    string `gorm:"column:team_name"`
 	HeadshotURL string `gorm:"column:headshot_url"`
+	Status      string `gorm:"-"` // Recorded in the status history rather than with the player
+	StatusFull  string `gorm:"-"`
+	InjuryNote  string `gorm:"-"`
 }
 
 type TeamWeeklyData struct {
@@ -178,9 +194,10 @@ type LeagueTeam struct {
 
 type Matchup struct {
 	MatchupKey  string `gorm:"column:matchup_key;primaryKey"`
-	Week        string `gorm:"column:week`
-	WinningTeam string `gorm:"column:winning_team`
-	LosingTeam  string `gorm:"column:losing_team`
+	Week        string `gorm:"column:week"`
+	WinningTeam string `gorm:"column:winning_team"` // For a tie, the teams keep Yahoo's order
+	LosingTeam  string `gorm:"column:losing_team"`
+	IsTied      bool   `gorm:"column:is_tied"`
 }
 
 type TeamWeeklyStats struct {
@@ -198,3 +215,45 @@ type StatWinnerWeeklyMatchup struct {
 	WinningTeamKey string `gorm:"column:winning_team_key"`
 	IsTied         bool   `gorm:"column:is_tied"`
 }
+
+type RosterPlayer struct {
+	PlayerKey         string   `json:"playerKey"`
+	PlayerID          string   `json:"playerId"`
+	Name              string   `json:"name"`
+	TeamAbbreviation  string   `json:"teamAbbr"`
+	DisplayPosition   string   `json:"displayPosition"`
+	PositionType      string   `json:"positionType"` // P for skaters, G for goalies
+	EligiblePositions []string `json:"eligiblePositions"`
+	SelectedPosition  string   `json:"selectedPosition"`
+	IsStarting        bool     `json:"isStarting"` // Placed in a scoring slot
+	Points            float64  `json:"points"`
+	Status            string   `json:"status,omitempty"` // IR, DTD, O and so on; empty when healthy
+	StatusFull        string   `json:"statusFull,omitempty"`
+	InjuryNote        string   `json:"injuryNote,omitempty"`
+	Stats             []Stat   `json:"stats,omitempty"`
+}
+
+type TeamRoster struct {
+	TeamKey      string         `json:"teamKey"`
+	CoverageType string         `json:"coverageType"` // week or date
+	Coverage     string         `json:"coverage"`     // Week number or YYYY-MM-DD
+	Players      []RosterPlayer `json:"players"`
+}
+
+type RosterEntry struct {
+	TeamKey           string    `gorm:"column:team_key;primaryKey" json:"teamKey"`
+	CoverageType      string    `gorm:"column:coverage_type;primaryKey" json:"coverageType"`
+	Coverage          string    `gorm:"column:coverage;primaryKey" json:"coverage"`
+	PlayerKey         string    `gorm:"column:player_key;primaryKey" json:"playerKey"`
+	PlayerName        string    `gorm:"column:player_name" json:"playerName"`
+	TeamAbbreviation  string    `gorm:"column:team_abbr" json:"teamAbbr"`
+	DisplayPosition   string    `gorm:"column:display_position" json:"displayPosition"`
+	PositionType      string    `gorm:"column:position_type" json:"positionType"`
+	EligiblePositions []string  `gorm:"column:eligible_positions;serializer:json" json:"eligiblePositions"`
+	SelectedPosition  string    `gorm:"column:selected_position" json:"selectedPosition"`
+	IsStarting        bool      `gorm:"column:is_starting" json:"isStarting"`
+	Points            float64   `gorm:"column:points" json:"points"`
+	Status            string    `gorm:"column:status" json:"status"`
+	InjuryNote        string    `gorm:"column:injury_note" json:"injuryNote"`
+	LastUpdated       time.Time `gorm:"autoUpdateTime" json:"lastUpdated"`
+}
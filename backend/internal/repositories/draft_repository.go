@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+func SaveDraftPicks(picks []models.DraftPick) error {
+	if len(picks) == 0 {
+		return nil
+	}
+
+	err := DB.Clauses(clause.OnConflict{UpdateAll: true}).Create(&picks).Error
+	if err != nil {
+		return fmt.Errorf("failed to save draft picks: %w", err)
+	}
+	return nil
+}
+
+// GetDraftPicks returns a league's stored draft picks in pick order
+func GetDraftPicks(leagueKey string) ([]models.DraftPick, error) {
+	var picks []models.DraftPick
+
+	err := DB.Where("league_key = ?", leagueKey).Order("pick ASC").Find(&picks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch draft picks for league %s: %w", leagueKey, err)
+	}
+
+	return picks, nil
+}
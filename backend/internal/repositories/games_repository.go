@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+func SaveGames(games []*models.LiveGame) error {
+	if len(games) == 0 {
+		return nil
+	}
+
+	err := DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}).Create(games).Error
+	if err != nil {
+		return fmt.Errorf("failed to save games: %w", err)
+	}
+	return nil
+}
+
+// GetGamesOnDate returns the stored scoreboard for a date (YYYY-MM-DD) in start time order
+func GetGamesOnDate(date string) ([]*models.LiveGame, error) {
+	var games []*models.LiveGame
+
+	err := DB.Where("game_date = ?", date).
+		Order("start_time_utc ASC").
+		Find(&games).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query games on %s: %w", date, err)
+	}
+
+	return games, nil
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveLeagueSeason upserts an archived season with its teams and matchups
+func SaveLeagueSeason(season *models.LeagueSeason, teams []models.SeasonTeam, matchups []models.SeasonMatchup) error {
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(season).Error; err != nil {
+			return err
+		}
+		if len(teams) > 0 {
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&teams).Error; err != nil {
+				return err
+			}
+		}
+		if len(matchups) > 0 {
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&matchups).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to archive league %s: %w", season.LeagueKey, err)
+	}
+	return nil
+}
+
+// GetLeagueSeason returns an archived season, or nil when it has not been archived
+func GetLeagueSeason(leagueKey string) (*models.LeagueSeason, error) {
+	var season models.LeagueSeason
+
+	err := DB.Where("league_key = ?", leagueKey).First(&season).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query archived league %s: %w", leagueKey, err)
+	}
+
+	return &season, nil
+}
+
+func GetSeasonTeams(leagueKeys []string) ([]models.SeasonTeam, error) {
+	var teams []models.SeasonTeam
+
+	err := DB.Where("league_key IN ?", leagueKeys).Find(&teams).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch archived teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+func GetSeasonMatchups(leagueKeys []string) ([]models.SeasonMatchup, error) {
+	var matchups []models.SeasonMatchup
+
+	err := DB.Where("league_key IN ?", leagueKeys).Order("league_key ASC, week ASC").Find(&matchups).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch archived matchups: %w", err)
+	}
+
+	return matchups, nil
+}
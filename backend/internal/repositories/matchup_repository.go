@@ -24,3 +24,15 @@ func SaveFTeamMatchups(matchups []*models.Matchup) error {
 
 	return nil
 }
+
+// GetLeagueMatchups fetches stored matchups for every team whose key belongs to the league
+func GetLeagueMatchups(leagueId string) ([]*models.Matchup, error) {
+	var matchups []*models.Matchup
+
+	err := DB.Where("winning_team LIKE ?", leagueId+".t.%").Find(&matchups).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch matchups for league %s: %w", leagueId, err)
+	}
+
+	return matchups, nil
+}
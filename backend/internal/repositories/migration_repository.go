@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm"
+)
+
+// migratedModels are the tables the application adds to, or extends in, the externally managed
+// schema
+var migratedModels = []interface{}{
+	&models.Matchup{},
+	&models.PlayerGameStat{},
+	&models.TeamStanding{},
+	&models.RosterEntry{},
+	&models.ScheduleGame{},
+	&models.ScheduleChange{},
+	&models.LiveGame{},
+	&models.Player{},
+	&models.PlayerStatus{},
+	&models.PlayerStatusChange{},
+	&models.Transaction{},
+	&models.TransactionPlayer{},
+	&models.DraftPick{},
+	&models.MockDraft{},
+	&models.MockDraftPlayer{},
+	&models.MockDraftPick{},
+	&models.LeagueSeason{},
+	&models.SeasonTeam{},
+	&models.SeasonMatchup{},
+}
+
+// Migrate creates missing tables and adds missing columns for the application's models. Existing
+// columns are never altered or dropped, so it is safe to run against the managed schema.
+func Migrate() error {
+	migrator := DB.Migrator()
+	for _, model := range migratedModels {
+		if !migrator.HasTable(model) {
+			if err := migrator.CreateTable(model); err != nil {
+				return fmt.Errorf("failed to create table for %T: %w", model, err)
+			}
+			log.Printf("Created table for %T", model)
+			continue
+		}
+
+		statement := &gorm.Statement{DB: DB}
+		if err := statement.Parse(model); err != nil {
+			return fmt.Errorf("failed to parse %T: %w", model, err)
+		}
+		for _, field := range statement.Schema.Fields {
+			if field.DBName == "" || migrator.HasColumn(model, field.DBName) {
+				continue
+			}
+			if err := migrator.AddColumn(model, field.Name); err != nil {
+				return fmt.Errorf("failed to add column %s.%s: %w", statement.Schema.Table, field.DBName, err)
+			}
+			log.Printf("Added column %s.%s", statement.Schema.Table, field.DBName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm"
+)
+
+// CreateMockDraft saves a new mock draft together with its ranked player pool
+func CreateMockDraft(draft *models.MockDraft, players []models.MockDraftPlayer) error {
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(draft).Error; err != nil {
+			return err
+		}
+		if len(players) == 0 {
+			return nil
+		}
+
+		for i := range players {
+			players[i].DraftID = draft.ID
+		}
+		return tx.Create(&players).Error
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to save mock draft: %w", err)
+	}
+	return nil
+}
+
+// GetMockDraft returns a mock draft, or nil when it does not exist
+func GetMockDraft(draftId uint) (*models.MockDraft, error) {
+	var draft models.MockDraft
+
+	err := DB.Where("id = ?", draftId).First(&draft).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query mock draft %d: %w", draftId, err)
+	}
+
+	return &draft, nil
+}
+
+// GetMockDraftPlayers returns a mock draft's player pool by rank
+func GetMockDraftPlayers(draftId uint) ([]models.MockDraftPlayer, error) {
+	var players []models.MockDraftPlayer
+
+	err := DB.Where("draft_id = ?", draftId).Order("`rank` ASC").Find(&players).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch players for mock draft %d: %w", draftId, err)
+	}
+
+	return players, nil
+}
+
+// GetMockDraftPicks returns a mock draft's picks in order
+func GetMockDraftPicks(draftId uint) ([]models.MockDraftPick, error) {
+	var picks []models.MockDraftPick
+
+	err := DB.Where("draft_id = ?", draftId).Order("pick ASC").Find(&picks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch picks for mock draft %d: %w", draftId, err)
+	}
+
+	return picks, nil
+}
+
+func SaveMockDraftPick(pick models.MockDraftPick) error {
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&pick).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.MockDraft{}).Where("id = ?", pick.DraftID).Update("updated_at", gorm.Expr("CURRENT_TIMESTAMP")).Error
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to save pick %d of mock draft %d: %w", pick.Pick, pick.DraftID, err)
+	}
+	return nil
+}
+
+func DeleteMockDraftPick(draftId uint, pick int) error {
+	err := DB.Where("draft_id = ? AND pick = ?", draftId, pick).Delete(&models.MockDraftPick{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete pick %d of mock draft %d: %w", pick, draftId, err)
+	}
+	return nil
+}
@@ -96,10 +96,14 @@ func SavePlayerIDMapping(yahooID string, nhlID string, name string, team string)
 	return nil
 }
 
+// yahooPlayerIDColumn strips the game prefix from stored Yahoo keys, matching utils.YahooPlayerID
+const yahooPlayerIDColumn = "SUBSTRING_INDEX(yahoo_player_id, '.', -2)"
+
+// GetNHLPlayerID returns the NHL id mapped to a Yahoo player key from any season's game
 func GetNHLPlayerID(yahooID string) (string, error) {
 	var playerMapping models.PlayerIDMapping
 
-	err := DB.First(&playerMapping, "yahoo_player_id = ?", yahooID).Error
+	err := DB.First(&playerMapping, yahooPlayerIDColumn+" = ?", utils.YahooPlayerID(yahooID)).Error
 	if err != nil {
 		return "", fmt.Errorf("failed to find NHL Player ID for Yahoo ID %s: %w", yahooID, err)
 	}
@@ -157,6 +161,38 @@ func SavePlayerIDMappingToDB(mappings []models.PlayerIDMapping) error {
 	return nil
 }
 
+func GetPlayerIDMappings() ([]models.PlayerIDMapping, error) {
+	var mappings []models.PlayerIDMapping
+
+	err := DB.Find(&mappings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch player ID mappings: %w", err)
+	}
+
+	return mappings, nil
+}
+
+// GetPlayerIDMappingsByYahooIDs returns the NHL mappings of the given Yahoo players from any
+// season's game. Mappings keep their stored key, so compare them with utils.YahooPlayerID.
+func GetPlayerIDMappingsByYahooIDs(yahooIds []string) ([]models.PlayerIDMapping, error) {
+	var mappings []models.PlayerIDMapping
+	if len(yahooIds) == 0 {
+		return mappings, nil
+	}
+
+	playerIds := make([]string, 0, len(yahooIds))
+	for _, yahooId := range yahooIds {
+		playerIds = append(playerIds, utils.YahooPlayerID(yahooId))
+	}
+
+	err := DB.Where(yahooPlayerIDColumn+" IN ?", playerIds).Find(&mappings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch player ID mappings: %w", err)
+	}
+
+	return mappings, nil
+}
+
 func GetMappedPlayerByName(playerName string) (*models.PlayerIDMapping, error) {
 	var player *models.PlayerIDMapping
 
@@ -184,15 +220,20 @@ func GetNhlPlayerById(playerId string) (*models.NHLPlayer, error) {
 
 	err := DB.Where("id = ?", playerId).First(&player).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch player %s: %w", &playerId, err)
+		return nil, fmt.Errorf("failed to fetch player %s: %w", playerId, err)
 	}
 
 	return player, nil
 }
 
+// SavePlayerGameStats upserts game log lines so refetched games pick up stat corrections
 func SavePlayerGameStats(playerGameStats []*models.PlayerGameStat) error {
+	if len(playerGameStats) == 0 {
+		return nil
+	}
+
 	err := DB.Clauses(clause.OnConflict{
-		DoNothing: true,
+		UpdateAll: true,
 	}).Create(playerGameStats).Error
 
 	if err != nil {
@@ -202,3 +243,48 @@ func SavePlayerGameStats(playerGameStats []*models.PlayerGameStat) error {
 	log.Printf("Successfully inserted player game stats")
 	return nil
 }
+
+func GetPlayerGameStatsDB(playerId string) ([]*models.PlayerGameStat, error) {
+	var playerGameStats []*models.PlayerGameStat
+
+	err := DB.Where("player_id = ?", playerId).
+		Order("game_date ASC").
+		Find(&playerGameStats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game stats for player %s: %w", playerId, err)
+	}
+
+	return playerGameStats, nil
+}
+
+// GetPlayersGameStatsSince returns the stored game log lines of the given NHL players on or after a
+// date (YYYY-MM-DD)
+func GetPlayersGameStatsSince(playerIds []string, date string) ([]*models.PlayerGameStat, error) {
+	var playerGameStats []*models.PlayerGameStat
+	if len(playerIds) == 0 {
+		return playerGameStats, nil
+	}
+
+	err := DB.Where("player_id IN ? AND game_date >= ?", playerIds, date).
+		Order("player_id ASC, game_date ASC").
+		Find(&playerGameStats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game stats since %s: %w", date, err)
+	}
+
+	return playerGameStats, nil
+}
+
+// GetPlayerGameStatsSince returns every stored game log line on or after a date (YYYY-MM-DD)
+func GetPlayerGameStatsSince(date string) ([]*models.PlayerGameStat, error) {
+	var playerGameStats []*models.PlayerGameStat
+
+	err := DB.Where("game_date >= ?", date).
+		Order("player_id ASC, game_date ASC").
+		Find(&playerGameStats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game stats since %s: %w", date, err)
+	}
+
+	return playerGameStats, nil
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm"
+)
+
+// SaveRosterEntries replaces the stored lineup of each team and coverage period in the entries
+// so players dropped since the last fetch do not linger.
+func SaveRosterEntries(entries []models.RosterEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		cleared := make(map[string]bool)
+		for _, entry := range entries {
+			period := entry.TeamKey + "|" + entry.CoverageType + "|" + entry.Coverage
+			if cleared[period] {
+				continue
+			}
+			cleared[period] = true
+
+			if err := tx.Where("team_key = ? AND coverage_type = ? AND coverage = ?", entry.TeamKey, entry.CoverageType, entry.Coverage).
+				Delete(&models.RosterEntry{}).Error; err != nil {
+				return fmt.Errorf("failed to clear roster for team %s: %w", entry.TeamKey, err)
+			}
+		}
+
+		if err := tx.Create(&entries).Error; err != nil {
+			return fmt.Errorf("failed to insert roster entries: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to save roster entries: %w", err)
+	}
+
+	return nil
+}
+
+func GetRosterEntries(teamKey, coverageType, coverage string) ([]models.RosterEntry, error) {
+	var entries []models.RosterEntry
+
+	err := DB.Where("team_key = ? AND coverage_type = ? AND coverage = ?", teamKey, coverageType, coverage).
+		Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch roster for team %s %s %s: %w", teamKey, coverageType, coverage, err)
+	}
+
+	return entries, nil
+}
+
+// GetTeamRosterHistory returns every stored lineup of a team. Weeks sort numerically so week 10
+// follows week 9; dates sort as stored.
+func GetTeamRosterHistory(teamKey string) ([]models.RosterEntry, error) {
+	var entries []models.RosterEntry
+
+	err := DB.Where("team_key = ?", teamKey).
+		Order("coverage_type ASC, CASE WHEN coverage_type = 'week' THEN CAST(coverage AS UNSIGNED) END ASC, coverage ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch roster history for team %s: %w", teamKey, err)
+	}
+
+	return entries, nil
+}
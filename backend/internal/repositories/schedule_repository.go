@@ -9,10 +9,13 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+// Postponed and cancelled games keep their original date until the league sets a new one
+const activeScheduleState = "(schedule_state IS NULL OR schedule_state NOT IN ('PPD', 'CNCL'))"
+
 func SaveScheduleGameInDB(scheduleGame *models.ScheduleGame) error {
 	err := DB.Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "id"}}, // Match on the primary key `id`
-		DoNothing: true,                          // Ignore the duplicate
+		UpdateAll: true,                          // Pick up new start times, states and scores
 	}).Create(scheduleGame).Error
 
 	if err != nil {
@@ -25,6 +28,7 @@ func GetTeamNextGameDB(teamAbbrev string) (*models.ScheduleGame, error) {
 	var nextGame models.ScheduleGame
 
 	err := DB.Where("(home_team_abbrev = ? OR away_team_abbrev = ?) AND start_time_utc > ?", teamAbbrev, teamAbbrev, time.Now()).
+		Where(activeScheduleState).
 		Order("start_time_utc ASC").
 		First(&nextGame).Error
 
@@ -47,3 +51,62 @@ func GetTeamNextGameDate(team_abbrev string) (*time.Time, error) {
 
 	return &nextGame.StartTimeUTC, nil
 }
+
+// GetScheduleGameByID returns a stored game, or nil when it has never been synced
+func GetScheduleGameByID(gameId int64) (*models.ScheduleGame, error) {
+	var game models.ScheduleGame
+
+	err := DB.Where("id = ?", gameId).First(&game).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query game %d: %w", gameId, err)
+	}
+
+	return &game, nil
+}
+
+// GetScheduleGamesBetween returns the games played between two dates (YYYY-MM-DD), inclusive,
+// leaving out postponed and cancelled games
+func GetScheduleGamesBetween(startDate, endDate string) ([]models.ScheduleGame, error) {
+	var games []models.ScheduleGame
+
+	err := DB.Where("game_date BETWEEN ? AND ?", startDate, endDate).
+		Where(activeScheduleState).
+		Order("start_time_utc ASC").
+		Find(&games).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query games between %s and %s: %w", startDate, endDate, err)
+	}
+
+	return games, nil
+}
+
+func SaveScheduleChanges(changes []models.ScheduleChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := DB.Create(&changes).Error; err != nil {
+		return fmt.Errorf("failed to save schedule changes: %w", err)
+	}
+	return nil
+}
+
+// GetScheduleChanges returns the changes detected since a time, newest first, optionally for one team
+func GetScheduleChanges(since time.Time, teamAbbrev string) ([]models.ScheduleChange, error) {
+	var changes []models.ScheduleChange
+
+	query := DB.Where("detected_at >= ?", since)
+	if teamAbbrev != "" {
+		query = query.Where("home_team_abbrev = ? OR away_team_abbrev = ?", teamAbbrev, teamAbbrev)
+	}
+
+	err := query.Order("detected_at DESC, id DESC").Find(&changes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedule changes: %w", err)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+func SaveTeamStandings(standings []models.TeamStanding) error {
+	if len(standings) == 0 {
+		return nil
+	}
+
+	err := DB.Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(&standings).Error
+	if err != nil {
+		return fmt.Errorf("failed to save team standings: %w", err)
+	}
+
+	return nil
+}
+
+func GetLeagueStandingsForWeek(leagueId string, week int) ([]models.TeamStanding, error) {
+	var standings []models.TeamStanding
+
+	err := DB.Where("league_id = ? AND week = ?", leagueId, week).
+		Order("`rank` ASC").
+		Find(&standings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch standings for league %s week %d: %w", leagueId, week, err)
+	}
+
+	return standings, nil
+}
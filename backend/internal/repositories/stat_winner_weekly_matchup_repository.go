@@ -24,3 +24,15 @@ func SaveStatWinnerWeeklyMatchup(statWinnerWeeklyMatchup []*models.StatWinnerWee
 
 	return nil
 }
+
+// GetLeagueStatWinners fetches stored category winners for every matchup in the league
+func GetLeagueStatWinners(leagueId string) ([]*models.StatWinnerWeeklyMatchup, error) {
+	var statWinners []*models.StatWinnerWeeklyMatchup
+
+	err := DB.Where("matchup_key LIKE ?", leagueId+".t.%").Find(&statWinners).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stat winners for league %s: %w", leagueId, err)
+	}
+
+	return statWinners, nil
+}
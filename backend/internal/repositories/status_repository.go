@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+// GetPlayerStatuses returns the stored statuses of the given players, keyed by player key
+func GetPlayerStatuses(playerKeys []string) (map[string]models.PlayerStatus, error) {
+	var statuses []models.PlayerStatus
+
+	err := DB.Where("player_key IN ?", playerKeys).Find(&statuses).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch player statuses: %w", err)
+	}
+
+	byKey := make(map[string]models.PlayerStatus, len(statuses))
+	for _, status := range statuses {
+		byKey[status.PlayerKey] = status
+	}
+	return byKey, nil
+}
+
+func SavePlayerStatuses(statuses []models.PlayerStatus, changes []models.PlayerStatusChange) error {
+	if len(statuses) > 0 {
+		err := DB.Clauses(clause.OnConflict{UpdateAll: true}).Create(&statuses).Error
+		if err != nil {
+			return fmt.Errorf("failed to save player statuses: %w", err)
+		}
+	}
+
+	if len(changes) > 0 {
+		if err := DB.Create(&changes).Error; err != nil {
+			return fmt.Errorf("failed to save player status changes: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetPlayerStatusHistory returns a player's status changes, newest first
+func GetPlayerStatusHistory(playerKey string) ([]models.PlayerStatusChange, error) {
+	var changes []models.PlayerStatusChange
+
+	err := DB.Where("player_key = ?", playerKey).
+		Order("changed_at DESC, id DESC").
+		Find(&changes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status history for player %s: %w", playerKey, err)
+	}
+
+	return changes, nil
+}
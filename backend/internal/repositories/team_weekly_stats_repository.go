@@ -55,3 +55,34 @@ func SaveTeamWeeklyStats(teamWeeklyStats []*models.TeamWeeklyStats) error {
 
 	return nil
 }
+
+// GetLeagueTeamWeeklyPoints fetches stored weekly points for every team in the league.
+// Stats are stored as JSON and are not loaded.
+func GetLeagueTeamWeeklyPoints(leagueId string) ([]models.TeamWeeklyStats, error) {
+	var rows []struct {
+		ID      string
+		Week    string
+		TeamKey string
+		Points  float64
+	}
+
+	err := DB.Table("team_weekly_stats").
+		Select("id, week, team_key, points").
+		Where("team_key LIKE ?", leagueId+".t.%").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch weekly points for league %s: %w", leagueId, err)
+	}
+
+	weeklyPoints := make([]models.TeamWeeklyStats, 0, len(rows))
+	for _, row := range rows {
+		weeklyPoints = append(weeklyPoints, models.TeamWeeklyStats{
+			ID:      row.ID,
+			Week:    row.Week,
+			TeamKey: row.TeamKey,
+			Points:  row.Points,
+		})
+	}
+
+	return weeklyPoints, nil
+}
@@ -20,7 +20,7 @@ func GetRefreshToken(userId string) (string, error) {
 	var refreshTokenEntry models.RefreshToken
 
 	if err := DB.First(&refreshTokenEntry, "user_id = ?", userId).Error; err != nil {
-		return "", fmt.Errorf("failed to get refresh token for user %s: $w", userId, err)
+		return "", fmt.Errorf("failed to get refresh token for user %s: %w", userId, err)
 	}
 
 	return refreshTokenEntry.RefreshToken, nil
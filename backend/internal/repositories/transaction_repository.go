@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+// SaveTransactions upserts transactions and the players they moved
+func SaveTransactions(transactions []models.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	var players []models.TransactionPlayer
+	for _, transaction := range transactions {
+		players = append(players, transaction.Players...)
+	}
+
+	err := DB.Clauses(clause.OnConflict{UpdateAll: true}).Create(&transactions).Error
+	if err != nil {
+		return fmt.Errorf("failed to save transactions: %w", err)
+	}
+
+	if len(players) > 0 {
+		err = DB.Clauses(clause.OnConflict{UpdateAll: true}).Create(&players).Error
+		if err != nil {
+			return fmt.Errorf("failed to save transaction players: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetLeagueTransactions returns a league's stored transactions with their players, newest first
+func GetLeagueTransactions(leagueKey string) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	err := DB.Where("league_key = ?", leagueKey).
+		Order("timestamp DESC").
+		Find(&transactions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transactions for league %s: %w", leagueKey, err)
+	}
+	if len(transactions) == 0 {
+		return transactions, nil
+	}
+
+	keys := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		keys = append(keys, transaction.TransactionKey)
+	}
+
+	var players []models.TransactionPlayer
+	err = DB.Where("transaction_key IN ?", keys).Find(&players).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transaction players for league %s: %w", leagueKey, err)
+	}
+
+	byTransaction := make(map[string][]models.TransactionPlayer)
+	for _, player := range players {
+		byTransaction[player.TransactionKey] = append(byTransaction[player.TransactionKey], player)
+	}
+	for i := range transactions {
+		transactions[i].Players = byTransaction[transactions[i].TransactionKey]
+		if transactions[i].Players == nil {
+			transactions[i].Players = []models.TransactionPlayer{}
+		}
+	}
+
+	return transactions, nil
+}
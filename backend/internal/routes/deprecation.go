@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// deprecated wraps a legacy route so responses advertise the /api/v1 successor.
+// Path variables in the successor template are filled from the matched request.
+func deprecated(successor string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		link := successor
+		for name, value := range mux.Vars(r) {
+			link = strings.ReplaceAll(link, "{"+name+"}", url.PathEscape(value))
+		}
+
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", "<"+link+">; rel=\"successor-version\"")
+		handler(w, r)
+	}
+}
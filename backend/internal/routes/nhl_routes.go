@@ -6,11 +6,11 @@ import (
 )
 
 func RegisterNHLRoutes(router *mux.Router) {
-	router.HandleFunc("/save-all-teams-schedule", handlers.SaveAllTeamsSchedule).Methods("GET")
-	router.HandleFunc("/get-next-game/{team}", handlers.GetTeamNextGameDate).Methods("GET")
-	router.HandleFunc("/get-player-game-stats/{playerId}", handlers.GetPlayerGameStats).Methods("GET")
-	router.HandleFunc("/get-player-game-stats/{playerId}/season/{season}", handlers.GetPlayerGameStats).Methods("GET")
-	router.HandleFunc("/get-team-roster/{teamAbrev}", handlers.GetTeamRoster).Methods("GET")
-	router.HandleFunc("/get-team-roster/{teamAbrev}/{season}", handlers.GetTeamRoster).Methods("GET")
-	router.HandleFunc("/map-players", handlers.SavePlayerIDMapping).Methods("POST")
+	router.HandleFunc("/save-all-teams-schedule", deprecated(apiV1Prefix+"/nhl/schedule/sync", handlers.SaveAllTeamsSchedule)).Methods("GET")
+	router.HandleFunc("/get-next-game/{teamAbrev}", deprecated(apiV1Prefix+"/nhl/teams/{teamAbrev}/next-game", handlers.GetTeamNextGameDate)).Methods("GET")
+	router.HandleFunc("/get-player-game-stats/{playerId}", deprecated(apiV1Prefix+"/nhl/players/{playerId}/game-log", handlers.GetPlayerGameStats)).Methods("GET")
+	router.HandleFunc("/get-player-game-stats/{playerId}/season/{season}", deprecated(apiV1Prefix+"/nhl/players/{playerId}/game-log/{season}", handlers.GetPlayerGameStats)).Methods("GET")
+	router.HandleFunc("/get-team-roster/{teamAbrev}", deprecated(apiV1Prefix+"/nhl/teams/{teamAbrev}/roster", handlers.GetTeamRoster)).Methods("GET")
+	router.HandleFunc("/get-team-roster/{teamAbrev}/{season}", deprecated(apiV1Prefix+"/nhl/teams/{teamAbrev}/roster/{season}", handlers.GetTeamRoster)).Methods("GET")
+	router.HandleFunc("/map-players", deprecated(apiV1Prefix+"/player-mappings", handlers.SavePlayerIDMapping)).Methods("POST")
 }
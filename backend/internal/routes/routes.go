@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/handlers"
+)
+
+// RegisterRoutes registers every route group and the OpenAPI document describing them.
+func RegisterRoutes(router *mux.Router) {
+	RegisterHealthRoutes(router)
+	RegisterAuthRoutes(router)
+	RegisterYahooRoutes(router)
+	RegisterNHLRoutes(router)
+	RegisterStatsRoutes(router)
+	RegisterSearchRoutes(router)
+	RegisterV1Routes(router)
+
+	router.HandleFunc("/openapi.json", handlers.GetOpenAPISpec(router)).Methods("GET")
+}
@@ -6,5 +6,5 @@ import (
 )
 
 func RegisterSearchRoutes(router *mux.Router) {
-	router.HandleFunc("/get-player-by-name/player/{playerName}", handlers.GetPlayerByName).Methods("GET")
+	router.HandleFunc("/get-player-by-name/player/{playerName}", deprecated(apiV1Prefix+"/players?name={playerName}", handlers.GetPlayerByName)).Methods("GET")
 }
@@ -6,5 +6,5 @@ import (
 )
 
 func RegisterStatsRoutes(router *mux.Router) {
-	router.HandleFunc("/get-fantasy-league-player-stats/league/{leagueId}/player/{playerId}", handlers.GetFantasyLeaguePlayerStats).Methods("GET")
+	router.HandleFunc("/get-fantasy-league-player-stats/league/{leagueId}/player/{playerId}", deprecated(apiV1Prefix+"/leagues/{leagueId}/players/{playerId}/stats", handlers.GetFantasyLeaguePlayerStats)).Methods("GET")
 }
@@ -0,0 +1,83 @@
+package routes
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/handlers"
+)
+
+const apiV1Prefix = "/api/v1"
+
+func RegisterV1Routes(router *mux.Router) {
+	v1 := router.PathPrefix(apiV1Prefix).Subrouter()
+
+	// Users
+	v1.HandleFunc("/users/me/leagues", handlers.GetUserLeaguesHandler).Methods("GET")
+	v1.HandleFunc("/users/me/dashboard", handlers.GetUserDashboard).Methods("GET")
+
+	// Leagues
+	v1.HandleFunc("/leagues/{leagueId}", handlers.GetLeagueInfo).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/settings", handlers.GetLeagueSettings).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/teams", handlers.GetAllTeamsInLeague).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/standings", handlers.GetLeagueStandings).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/luck", handlers.GetLeagueLuckAnalysis).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/categories", handlers.GetLeagueCategoryAnalysis).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/rosters", handlers.GetLeagueRosters).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/injuries", handlers.GetLeagueInjuries).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/schedule", handlers.GetLeagueWeekSchedule).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/trade-analysis", handlers.AnalyzeTrade).Methods("POST")
+	v1.HandleFunc("/leagues/{leagueId}/transactions", handlers.GetLeagueTransactions).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/transactions/analysis", handlers.AnalyzeLeagueTransactions).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/draft-results", handlers.GetDraftResults).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/draft/analysis", handlers.AnalyzeDraft).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/history", handlers.GetLeagueHistory).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/history/sync", handlers.ArchiveLeagueHistory).Methods("POST")
+	v1.HandleFunc("/leagues/{leagueId}/mock-drafts", handlers.CreateMockDraft).Methods("POST")
+	v1.HandleFunc("/mock-drafts/{draftId}", handlers.GetMockDraft).Methods("GET")
+	v1.HandleFunc("/mock-drafts/{draftId}/picks", handlers.RecordMockDraftPick).Methods("POST")
+	v1.HandleFunc("/mock-drafts/{draftId}/picks/last", handlers.UndoMockDraftPick).Methods("DELETE")
+	v1.HandleFunc("/leagues/{leagueId}/compare", handlers.ComparePlayers).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/players/{playerId}/stats", handlers.GetFantasyLeaguePlayerStats).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/players/{playerId}/ranks", handlers.GetPlayerRankLeague).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/players/{playerId}/projection", handlers.GetPlayerProjection).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/players/{playerId}/fantasy-points", handlers.GetPlayerPointsSeries).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/goalies/{playerId}/outlook", handlers.GetGoalieOutlook).Methods("GET")
+	v1.HandleFunc("/leagues/{leagueId}/projections/backtest", handlers.BacktestProjections).Methods("GET")
+
+	// Fantasy teams
+	v1.HandleFunc("/teams/{teamId}/matchups", handlers.GetFTeamMatchups).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/matchup-preview", handlers.GetMatchupPreview).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/weekly-stats", handlers.GetTeamWeeklyStats).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/projected-vs-actual", handlers.GetProjectedVsActual).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/roster", handlers.GetFantasyTeamRoster).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/roster/history", handlers.GetFantasyTeamRosterHistory).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/lineup/optimize", handlers.OptimizeTeamLineup).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/games-remaining", handlers.GetTeamGamesRemaining).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/waiver-recommendations", handlers.GetWaiverRecommendations).Methods("GET")
+	v1.HandleFunc("/teams/{teamId}/today", handlers.GetTeamToday).Methods("GET")
+
+	// Players
+	v1.HandleFunc("/players", handlers.GetPlayerByName).Methods("GET").Queries("name", "{playerName}")
+	v1.HandleFunc("/players/sync", handlers.GetAllPlayersYahoo).Methods("POST")
+	v1.HandleFunc("/players/{playerId}/stats", handlers.GetPlayerStats).Methods("GET")
+	v1.HandleFunc("/players/{playerId}/status-history", handlers.GetPlayerStatusHistory).Methods("GET")
+	v1.HandleFunc("/player-mappings", handlers.SavePlayerIDMapping).Methods("POST")
+
+	// NHL
+	v1.HandleFunc("/nhl/schedule/sync", handlers.SaveAllTeamsSchedule).Methods("POST")
+	v1.HandleFunc("/nhl/schedule/changes", handlers.GetScheduleChanges).Methods("GET")
+	v1.HandleFunc("/nhl/scoreboard", handlers.GetScoreboard).Methods("GET")
+	v1.HandleFunc("/nhl/scoreboard/sync", handlers.SyncScoreboard).Methods("POST")
+	v1.HandleFunc("/nhl/teams/{teamAbrev}/next-game", handlers.GetTeamNextGameDate).Methods("GET")
+	v1.HandleFunc("/nhl/teams/{teamAbrev}/roster", handlers.GetTeamRoster).Methods("GET")
+	v1.HandleFunc("/nhl/teams/{teamAbrev}/roster/{season}", handlers.GetTeamRoster).Methods("GET")
+	v1.HandleFunc("/nhl/players/{playerId}/game-log", handlers.GetPlayerGameStats).Methods("GET")
+	v1.HandleFunc("/nhl/players/{playerId}/game-log/{season}", handlers.GetPlayerGameStats).Methods("GET")
+	v1.HandleFunc("/nhl/players/{playerId}/seasons", handlers.GetPlayerSeasonTotals).Methods("GET")
+	v1.HandleFunc("/nhl/players/{playerId}/career", handlers.GetPlayerCareer).Methods("GET")
+	v1.HandleFunc("/nhl/game-logs/backfill", handlers.BackfillGameLogs).Methods("POST")
+	v1.HandleFunc("/nhl/game-logs/backfill", handlers.GetGameLogBackfill).Methods("GET")
+
+	// Cache
+	v1.HandleFunc("/cache", handlers.ClearCache).Methods("DELETE")
+	v1.HandleFunc("/cache/{operation}", handlers.ClearCache).Methods("DELETE")
+}
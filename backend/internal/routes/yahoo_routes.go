@@ -6,13 +6,13 @@ import (
 )
 
 func RegisterYahooRoutes(router *mux.Router) {
-	router.HandleFunc("/get-user-leagues", handlers.GetUserLeaguesHandler).Methods("GET")
-	router.HandleFunc("/get-league-info/{leagueId}", handlers.GetLeagueInfo).Methods("GET")
-	router.HandleFunc("/get-league-settings/{leagueId}", handlers.GetLeagueSettings).Methods("GET")
-	router.HandleFunc("/get-team-weekly/team/{teamId}", handlers.GetTeamWeeklyStats).Methods("GET")
-	router.HandleFunc("/get-player-stats/player/{playerId}", handlers.GetPlayerStats).Methods("GET")
-	router.HandleFunc("/get-player-rank/league/{leagueId}/player/{playerId}", handlers.GetPlayerRankLeague).Methods("GET")
-	router.HandleFunc("/get-all-players", handlers.GetAllPlayersYahoo).Methods("GET")
-	router.HandleFunc("/get-league-teams/league/{leagueId}", handlers.GetAllTeamsInLeague).Methods("GET")
-	router.HandleFunc("/get-fteam-matchups/team/{teamId}", handlers.GetFTeamMatchups).Methods("GET")
+	router.HandleFunc("/get-user-leagues", deprecated(apiV1Prefix+"/users/me/leagues", handlers.GetUserLeaguesHandler)).Methods("GET")
+	router.HandleFunc("/get-league-info/{leagueId}", deprecated(apiV1Prefix+"/leagues/{leagueId}", handlers.GetLeagueInfo)).Methods("GET")
+	router.HandleFunc("/get-league-settings/{leagueId}", deprecated(apiV1Prefix+"/leagues/{leagueId}/settings", handlers.GetLeagueSettings)).Methods("GET")
+	router.HandleFunc("/get-team-weekly/team/{teamId}", deprecated(apiV1Prefix+"/teams/{teamId}/weekly-stats", handlers.GetTeamWeeklyStats)).Methods("GET")
+	router.HandleFunc("/get-player-stats/player/{playerId}", deprecated(apiV1Prefix+"/players/{playerId}/stats", handlers.GetPlayerStats)).Methods("GET")
+	router.HandleFunc("/get-player-rank/league/{leagueId}/player/{playerId}", deprecated(apiV1Prefix+"/leagues/{leagueId}/players/{playerId}/ranks", handlers.GetPlayerRankLeague)).Methods("GET")
+	router.HandleFunc("/get-all-players", deprecated(apiV1Prefix+"/players/sync", handlers.GetAllPlayersYahoo)).Methods("GET")
+	router.HandleFunc("/get-league-teams/league/{leagueId}", deprecated(apiV1Prefix+"/leagues/{leagueId}/teams", handlers.GetAllTeamsInLeague)).Methods("GET")
+	router.HandleFunc("/get-fteam-matchups/team/{teamId}", deprecated(apiV1Prefix+"/teams/{teamId}/matchups", handlers.GetFTeamMatchups)).Methods("GET")
 }
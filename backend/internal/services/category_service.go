@@ -0,0 +1,204 @@
+package services
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	categoryRecentWeeks      = 3
+	categoryTrendThreshold   = 0.2
+	categoryDominantMinimum  = 0.65
+	categoryPuntedMaximum    = 0.35
+	categoryMinimumDecisions = 3
+)
+
+func GetLeagueCategoryAnalysis(sessionId, leagueId string) (*models.LeagueCategoryResponse, error) {
+	teams, err := GetAllTeamsInLeague(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SyncLeagueMatchups(sessionId, leagueId); err != nil {
+		return nil, err
+	}
+
+	matchups, err := repositories.GetLeagueMatchups(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	statWinners, err := repositories.GetLeagueStatWinners(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	teamNames := make(map[string]string)
+	for _, team := range teams {
+		teamNames[team.TeamId] = team.Name
+	}
+
+	return &models.LeagueCategoryResponse{
+		LeagueID: leagueId,
+		Teams:    ComputeCategoryAnalysis(teamNames, matchups, statWinners),
+	}, nil
+}
+
+// ComputeCategoryAnalysis aggregates per-category wins, losses and ties for every team
+// and compares the most recent weeks with the full season to label trends.
+func ComputeCategoryAnalysis(teamNames map[string]string, matchups []*models.Matchup, statWinners []*models.StatWinnerWeeklyMatchup) []models.TeamCategoryAnalysis {
+	statNames := utils.GetStatIDToNameMap()
+
+	matchupTeams := make(map[string][2]string)
+	for _, matchup := range matchups {
+		matchupTeams[matchup.MatchupKey] = [2]string{matchup.WinningTeam, matchup.LosingTeam}
+	}
+
+	// team key -> stat id -> record
+	records := make(map[string]map[string]*models.CategoryRecord)
+	getRecord := func(teamKey, statId string) *models.CategoryRecord {
+		if records[teamKey] == nil {
+			records[teamKey] = make(map[string]*models.CategoryRecord)
+		}
+		if record, ok := records[teamKey][statId]; ok {
+			return record
+		}
+		record := &models.CategoryRecord{StatID: statId, StatName: statNames[statId]}
+		records[teamKey][statId] = record
+		return record
+	}
+
+	for _, statWinner := range statWinners {
+		teams, ok := matchupTeams[statWinner.MatchupKey]
+		if !ok {
+			continue
+		}
+
+		week, err := strconv.Atoi(statWinner.Week)
+		if err != nil {
+			continue
+		}
+
+		for i, teamKey := range teams {
+			opponent := teams[1-i]
+			record := getRecord(teamKey, statWinner.StatID)
+
+			result := "L"
+			switch {
+			case statWinner.IsTied:
+				result = "T"
+				record.Ties++
+			case statWinner.WinningTeamKey == teamKey:
+				result = "W"
+				record.Wins++
+			default:
+				record.Losses++
+			}
+
+			record.Results = append(record.Results, models.CategoryWeekResult{
+				Week:     week,
+				Opponent: opponent,
+				Result:   result,
+			})
+		}
+	}
+
+	for teamKey := range teamNames {
+		if records[teamKey] == nil {
+			records[teamKey] = make(map[string]*models.CategoryRecord)
+		}
+	}
+
+	var result []models.TeamCategoryAnalysis
+	for teamKey, teamRecords := range records {
+		analysis := models.TeamCategoryAnalysis{
+			TeamKey:    teamKey,
+			Name:       teamNames[teamKey],
+			Categories: []models.CategoryRecord{},
+			Dominant:   []string{},
+			Punted:     []string{},
+		}
+
+		for _, record := range teamRecords {
+			sort.Slice(record.Results, func(i, j int) bool {
+				return record.Results[i].Week < record.Results[j].Week
+			})
+
+			record.WinPercentage = utils.RoundFloat(categoryWinPercentage(record.Results), 3)
+
+			recent := record.Results
+			if len(recent) > categoryRecentWeeks {
+				recent = recent[len(recent)-categoryRecentWeeks:]
+			}
+			record.RecentWinPercentage = utils.RoundFloat(categoryWinPercentage(recent), 3)
+
+			switch delta := record.RecentWinPercentage - record.WinPercentage; {
+			case delta >= categoryTrendThreshold:
+				record.Trend = "improving"
+			case delta <= -categoryTrendThreshold:
+				record.Trend = "declining"
+			default:
+				record.Trend = "steady"
+			}
+
+			analysis.Wins += record.Wins
+			analysis.Losses += record.Losses
+			analysis.Ties += record.Ties
+
+			label := record.StatName
+			if label == "" {
+				label = record.StatID
+			}
+			if len(record.Results) >= categoryMinimumDecisions {
+				if record.WinPercentage >= categoryDominantMinimum {
+					analysis.Dominant = append(analysis.Dominant, label)
+				} else if record.WinPercentage <= categoryPuntedMaximum {
+					analysis.Punted = append(analysis.Punted, label)
+				}
+			}
+
+			analysis.Categories = append(analysis.Categories, *record)
+		}
+
+		sort.Slice(analysis.Categories, func(i, j int) bool {
+			if analysis.Categories[i].WinPercentage != analysis.Categories[j].WinPercentage {
+				return analysis.Categories[i].WinPercentage > analysis.Categories[j].WinPercentage
+			}
+			return analysis.Categories[i].StatID < analysis.Categories[j].StatID
+		})
+		sort.Strings(analysis.Dominant)
+		sort.Strings(analysis.Punted)
+
+		result = append(result, analysis)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Wins != result[j].Wins {
+			return result[i].Wins > result[j].Wins
+		}
+		return result[i].TeamKey < result[j].TeamKey
+	})
+
+	return result
+}
+
+func categoryWinPercentage(results []models.CategoryWeekResult) float64 {
+	if len(results) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, result := range results {
+		switch result.Result {
+		case "W":
+			total++
+		case "T":
+			total += 0.5
+		}
+	}
+	return total / float64(len(results))
+}
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// ComparePlayers gathers league-adjusted stats, ranks, form and remaining schedule for players
+// and lines them up in one table
+func ComparePlayers(sessionId, leagueId string, playerKeys []string) (*models.PlayerComparison, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().Format(lineupDateLayout)
+	var remainingSchedule []models.ScheduleGame
+	if !settings.EndDate.IsZero() && settings.EndDate.Format(lineupDateLayout) >= today {
+		remainingSchedule, err = repositories.GetScheduleGamesBetween(today, settings.EndDate.Format(lineupDateLayout))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pointPcts, err := GetNHLPointPercentages()
+	if err != nil {
+		log.Printf("Failed to get NHL standings for schedule strength: %v", err)
+	}
+
+	comparison := &models.PlayerComparison{
+		LeagueKey: leagueId,
+		Players:   []models.ComparedPlayer{},
+	}
+	statPoints := make(map[string]map[string]float64)
+
+	for _, playerKey := range playerKeys {
+		player, err := GetPlayerStats(sessionId, playerKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stats for player %s: %w", playerKey, err)
+		}
+
+		perGame, err := seasonPointsPerGame(settings.StatModifiers, *player)
+		if err != nil {
+			return nil, err
+		}
+
+		adjusted, totalPoints, err := GetLeaguePlayerStats(settings.StatModifiers, *player)
+		if err != nil {
+			return nil, err
+		}
+
+		compared := models.ComparedPlayer{
+			PlayerKey:         playerKey,
+			Name:              player.Name.FullName,
+			Team:              player.TeamAbbreviation,
+			DisplayPosition:   player.DisplayPosition,
+			EligiblePositions: player.EligiblePositions,
+			TotalPoints:       utils.RoundFloat(totalPoints, 2),
+			PointsPerGame:     utils.RoundFloat(perGame, 2),
+			CurrentForm:       "neutral",
+			Ranks:             []models.PlayerRank{},
+		}
+
+		statPoints[playerKey] = make(map[string]float64)
+		for _, modifier := range settings.StatModifiers {
+			statPoints[playerKey][modifier.StatID] = 0
+		}
+		for _, stat := range adjusted.Stats {
+			if _, scored := statPoints[playerKey][stat.StatID]; scored {
+				statPoints[playerKey][stat.StatID], _ = strconv.ParseFloat(stat.Value, 64)
+			}
+		}
+
+		if ranks, err := GetPlayerRankLeague(sessionId, leagueId, playerKey); err != nil {
+			log.Printf("Failed to get ranks for player %s: %v", playerKey, err)
+		} else if ranks != nil {
+			compared.Ranks = ranks
+		}
+
+		if series, err := GetPlayerPointsSeries(sessionId, leagueId, playerKey, "", DefaultPointsWindow); err != nil {
+			log.Printf("Failed to get recent form for player %s: %v", playerKey, err)
+		} else {
+			compared.RecentAverage = series.RecentAverage
+			compared.CurrentForm = series.CurrentForm
+		}
+
+		compared.RemainingGames, compared.OpponentPointPct = ScheduleStrength(utils.ToNHLTeamAbbreviation(player.TeamAbbreviation), remainingSchedule, pointPcts)
+
+		comparison.Players = append(comparison.Players, compared)
+	}
+
+	comparison.Rows = BuildComparisonRows(comparison.Players, settings.StatModifiers, statPoints)
+	return comparison, nil
+}
+
+// GetNHLPointPercentages returns each NHL team's current point percentage keyed by abbreviation
+func GetNHLPointPercentages() (map[string]float64, error) {
+	response, err := GetHttpRequest("https://api-web.nhle.com/v1/standings/now")
+	if err != nil {
+		return nil, err
+	}
+
+	standings, ok := response["standings"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected standings response format")
+	}
+
+	pointPcts := make(map[string]float64)
+	for _, entry := range standings {
+		team, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		abbrev, ok := team["teamAbbrev"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := abbrev["default"].(string)
+		pct, _ := team["pointPctg"].(float64)
+		pointPcts[name] = pct
+	}
+
+	return pointPcts, nil
+}
+
+// ScheduleStrength counts a team's remaining games and averages its opponents' point percentage
+func ScheduleStrength(team string, games []models.ScheduleGame, pointPcts map[string]float64) (int, float64) {
+	count := 0
+	total := 0.0
+	for _, game := range games {
+		opponent := ""
+		if game.HomeTeamAbbrev == team {
+			opponent = game.AwayTeamAbbrev
+		} else if game.AwayTeamAbbrev == team {
+			opponent = game.HomeTeamAbbrev
+		} else {
+			continue
+		}
+		count++
+		total += pointPcts[opponent]
+	}
+
+	if count == 0 || len(pointPcts) == 0 {
+		return count, 0
+	}
+	return count, utils.RoundFloat(total/float64(count), 3)
+}
+
+// BuildComparisonRows lays out summary metrics, points per scored stat and ranks with a leader
+// per row. Values are normalized so the leader scores 1.
+func BuildComparisonRows(players []models.ComparedPlayer, statModifiers []models.StatModifier, statPoints map[string]map[string]float64) []models.ComparisonRow {
+	var rows []models.ComparisonRow
+
+	addRow := func(metric, statID string, lowerIsBetter bool, value func(models.ComparedPlayer) (float64, bool)) {
+		row := models.ComparisonRow{
+			Metric:        metric,
+			StatID:        statID,
+			LowerIsBetter: lowerIsBetter,
+			Values:        map[string]float64{},
+			Normalized:    map[string]float64{},
+		}
+		for _, player := range players {
+			if v, ok := value(player); ok {
+				row.Values[player.PlayerKey] = v
+			}
+		}
+		NormalizeRow(&row)
+		rows = append(rows, row)
+	}
+
+	addRow("Fantasy points", "", false, func(p models.ComparedPlayer) (float64, bool) { return p.TotalPoints, true })
+	addRow("Points per game", "", false, func(p models.ComparedPlayer) (float64, bool) { return p.PointsPerGame, true })
+	addRow("Recent average", "", false, func(p models.ComparedPlayer) (float64, bool) { return p.RecentAverage, true })
+	addRow("Remaining games", "", false, func(p models.ComparedPlayer) (float64, bool) { return float64(p.RemainingGames), true })
+	addRow("Opponent point %", "", true, func(p models.ComparedPlayer) (float64, bool) {
+		return p.OpponentPointPct, p.OpponentPointPct > 0
+	})
+
+	statNames := utils.GetStatIDToNameMap()
+	for _, modifier := range statModifiers {
+		name := modifier.StatName
+		if name == "" {
+			name = statNames[modifier.StatID]
+		}
+		statID := modifier.StatID
+		// Negative modifiers such as penalty minutes still rank the highest points first
+		addRow(name+" points", statID, false, func(p models.ComparedPlayer) (float64, bool) {
+			v, ok := statPoints[p.PlayerKey][statID]
+			return v, ok
+		})
+	}
+
+	var rankTypes []string
+	seen := make(map[string]bool)
+	for _, player := range players {
+		for _, rank := range player.Ranks {
+			if !seen[rank.RankType] {
+				seen[rank.RankType] = true
+				rankTypes = append(rankTypes, rank.RankType)
+			}
+		}
+	}
+	sort.Strings(rankTypes)
+	for _, rankType := range rankTypes {
+		rankType := rankType
+		addRow("Rank "+rankType, "", true, func(p models.ComparedPlayer) (float64, bool) {
+			for _, rank := range p.Ranks {
+				if rank.RankType == rankType && rank.RankValue > 0 {
+					return float64(rank.RankValue), true
+				}
+			}
+			return 0, false
+		})
+	}
+
+	return rows
+}
+
+// NormalizeRow picks a row's leader and scales each value against the leader's. A row where every
+// player is level has no leader.
+func NormalizeRow(row *models.ComparisonRow) {
+	best := 0.0
+	first := true
+	for playerKey, value := range row.Values {
+		better := value > best
+		if row.LowerIsBetter {
+			better = value < best
+		}
+		if first || better || (value == best && playerKey < row.Leader) {
+			best = value
+			row.Leader = playerKey
+			first = false
+		}
+	}
+
+	tied := len(row.Values) > 1
+	for _, value := range row.Values {
+		if value != best {
+			tied = false
+		}
+	}
+	if tied {
+		row.Leader = ""
+	}
+
+	for playerKey, value := range row.Values {
+		switch {
+		case row.LowerIsBetter && value > 0:
+			row.Normalized[playerKey] = utils.RoundFloat(best/value, 3)
+		case !row.LowerIsBetter && best > 0:
+			row.Normalized[playerKey] = utils.RoundFloat(value/best, 3)
+		case value == best:
+			row.Normalized[playerKey] = 1
+		default:
+			row.Normalized[playerKey] = 0
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// GetUserDashboard summarises every active NHL league the user plays in. A league that fails to
+// load part of its summary reports the failure in its entry rather than failing the dashboard.
+func GetUserDashboard(sessionId string) (*models.UserDashboard, error) {
+	today := time.Now().Format(lineupDateLayout)
+
+	leaguesResponse, err := AuthHttpXMLRequest(sessionId, "https://fantasysports.yahooapis.com/fantasy/v2/users;use_login=1/games;game_codes=nhl/leagues")
+	if err != nil {
+		return nil, err
+	}
+	leagues, err := MapUserLeagues(leaguesResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map user leagues: %w", err)
+	}
+
+	teamsResponse, err := AuthHttpXMLRequest(sessionId, "https://fantasysports.yahooapis.com/fantasy/v2/users;use_login=1/games;game_codes=nhl/teams")
+	if err != nil {
+		return nil, err
+	}
+	teams, err := MapUserTeams(teamsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map user teams: %w", err)
+	}
+	ownTeams := make(map[string]models.LeagueTeam)
+	for _, team := range teams {
+		ownTeams[team.LeagueId] = team
+	}
+
+	games, err := repositories.GetScheduleGamesBetween(today, today)
+	if err != nil {
+		return nil, err
+	}
+	playing := gamesPerTeam(games)
+
+	dashboard := &models.UserDashboard{Date: today, Leagues: []models.LeagueDashboard{}}
+	for _, league := range leagues {
+		if !IsActiveLeague(league, today) {
+			continue
+		}
+		dashboard.Leagues = append(dashboard.Leagues, buildLeagueDashboard(sessionId, league, ownTeams[league.LeagueKey], today, playing))
+	}
+
+	return dashboard, nil
+}
+
+// IsActiveLeague reports whether an NHL league is still being played on the given date
+func IsActiveLeague(league models.UserLeague, today string) bool {
+	if league.GameCode != "nhl" || league.IsFinished {
+		return false
+	}
+	return league.EndDate == "" || league.EndDate >= today
+}
+
+// GetTeamMatchup fetches a team's matchup for a week with both sides' points so far
+func GetTeamMatchup(sessionId, teamKey string, week int) (*models.MatchupScore, error) {
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/team/%s/matchups;weeks=%d", teamKey, week)
+	response, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, err
+	}
+
+	matchup, err := MapTeamMatchup(response, teamKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map matchup for team %s: %w", teamKey, err)
+	}
+	return matchup, nil
+}
+
+// BuildLineupIssues flags starting slots left empty, starters carrying an injury status and, on
+// days with NHL games, starters whose team is not playing
+func BuildLineupIssues(positions []models.RosterPosition, players []models.RosterPlayer, playing map[string]int) []models.LineupIssue {
+	issues := []models.LineupIssue{}
+
+	filled := make(map[string]int)
+	for _, player := range players {
+		filled[player.SelectedPosition]++
+	}
+	for _, position := range positions {
+		if utils.IsBenchPosition(position.Position) {
+			continue
+		}
+		for i := filled[position.Position]; i < position.Count; i++ {
+			issues = append(issues, models.LineupIssue{
+				Type:     models.LineupIssueEmptySlot,
+				Position: position.Position,
+				Detail:   fmt.Sprintf("%s slot is empty", position.Position),
+			})
+		}
+	}
+
+	for _, player := range players {
+		if utils.IsBenchPosition(player.SelectedPosition) {
+			continue
+		}
+
+		if player.Status != "" {
+			status := player.Status
+			if player.InjuryNote != "" {
+				status += ": " + player.InjuryNote
+			}
+			detail := fmt.Sprintf("Questionable (%s)", status)
+			if utils.IsRuledOut(player.Status) {
+				detail = fmt.Sprintf("Ruled out (%s)", status)
+			}
+
+			issues = append(issues, models.LineupIssue{
+				Type:       models.LineupIssueInjuredStarter,
+				Position:   player.SelectedPosition,
+				PlayerKey:  player.PlayerKey,
+				PlayerName: player.Name,
+				Detail:     detail,
+			})
+			continue
+		}
+
+		if len(playing) > 0 && playing[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)] == 0 {
+			issues = append(issues, models.LineupIssue{
+				Type:       models.LineupIssueNoGame,
+				Position:   player.SelectedPosition,
+				PlayerKey:  player.PlayerKey,
+				PlayerName: player.Name,
+				Detail:     fmt.Sprintf("%s has no game today", player.TeamAbbreviation),
+			})
+		}
+	}
+
+	return issues
+}
+
+func buildLeagueDashboard(sessionId string, league models.UserLeague, team models.LeagueTeam, today string, playing map[string]int) models.LeagueDashboard {
+	entry := models.LeagueDashboard{
+		LeagueKey:    league.LeagueKey,
+		LeagueName:   league.Name,
+		Season:       league.Season,
+		CurrentWeek:  league.CurrentWeek,
+		NumTeams:     league.NumTeams,
+		TeamKey:      team.TeamId,
+		TeamName:     team.Name,
+		LineupIssues: []models.LineupIssue{},
+	}
+	if team.TeamId == "" {
+		entry.Error = "user has no team in this league"
+		return entry
+	}
+
+	var failures []string
+
+	if league.DraftStatus == "postdraft" {
+		matchup, err := GetTeamMatchup(sessionId, team.TeamId, league.CurrentWeek)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("matchup: %v", err))
+		}
+		entry.Matchup = matchup
+	}
+
+	standing, err := getTeamStanding(sessionId, league.LeagueKey, team.TeamId)
+	if err != nil {
+		failures = append(failures, fmt.Sprintf("standings: %v", err))
+	}
+	entry.Standing = standing
+
+	settings, err := GetLeagueSettings(sessionId, league.LeagueKey)
+	if err != nil {
+		failures = append(failures, fmt.Sprintf("settings: %v", err))
+	} else {
+		roster, err := GetFantasyTeamRoster(sessionId, team.TeamId, "", today)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("roster: %v", err))
+		} else {
+			entry.LineupIssues = BuildLineupIssues(settings.RosterPositions, roster.Players, playing)
+		}
+	}
+
+	entry.Error = strings.Join(failures, "; ")
+	return entry
+}
+
+// getTeamStanding refreshes a league's standings and returns the team's row
+func getTeamStanding(sessionId, leagueKey, teamKey string) (*models.TeamStanding, error) {
+	standings, err := syncLeagueStandings(sessionId, leagueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range standings {
+		if standings[i].TeamKey == teamKey {
+			return &standings[i], nil
+		}
+	}
+	return nil, nil
+}
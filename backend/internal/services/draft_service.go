@@ -0,0 +1,249 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	draftLeaders = 10
+	draftSteal   = "steal"
+	draftBust    = "bust"
+)
+
+// GetDraftResults returns a league's draft picks. Stored picks are final once the draft is over;
+// before that they are refetched so a live draft stays current.
+func GetDraftResults(sessionId, leagueId string) ([]models.DraftPick, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.DraftStatus == "postdraft" {
+		stored, err := repositories.GetDraftPicks(leagueId)
+		if err != nil {
+			return nil, err
+		}
+		if len(stored) > 0 {
+			return stored, nil
+		}
+	}
+
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/draftresults", leagueId)
+	response, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch draft results for league %s: %w", leagueId, err)
+	}
+
+	picks, err := MapDraftResults(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map draft results: %w", err)
+	}
+
+	if err := repositories.SaveDraftPicks(picks); err != nil {
+		return nil, err
+	}
+	return picks, nil
+}
+
+// AnalyzeDraft grades every pick by the season fantasy points the player has scored
+func AnalyzeDraft(sessionId, leagueId string) (*models.DraftAnalysis, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	picks, err := GetDraftResults(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+	if len(picks) == 0 {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("league %s has no draft results", leagueId))
+	}
+
+	teams, err := GetAllTeamsInLeague(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+	teamNames := make(map[string]string)
+	for _, team := range teams {
+		teamNames[team.TeamId] = team.Name
+	}
+
+	playerKeys := make([]string, 0, len(picks))
+	for _, pick := range picks {
+		playerKeys = append(playerKeys, pick.PlayerKey)
+	}
+	players, err := GetLeaguePlayersByKeys(sessionId, leagueId, playerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := BuildDraftAnalysis(picks, players, settings.StatModifiers, teamNames, settings.NumTeams)
+	analysis.LeagueKey = leagueId
+	return &analysis, nil
+}
+
+// BuildDraftAnalysis compares each pick's season points with the points of the player whose
+// value rank matches the slot. Beating or missing the slot by a full round of value is a steal
+// or a bust, and managers are graded on their total surplus relative to the league.
+func BuildDraftAnalysis(picks []models.DraftPick, players []models.Player, statModifiers []models.StatModifier, teamNames map[string]string, numTeams int) models.DraftAnalysis {
+	analysis := models.DraftAnalysis{
+		Managers: []models.ManagerDraftGrade{},
+		Steals:   []models.GradedPick{},
+		Busts:    []models.GradedPick{},
+		Picks:    []models.GradedPick{},
+	}
+	if numTeams <= 0 {
+		numTeams = len(teamNames)
+	}
+
+	byKey := make(map[string]models.Player)
+	for _, player := range players {
+		byKey[player.PlayerKey] = player
+	}
+
+	sortedPicks := append([]models.DraftPick(nil), picks...)
+	sort.SliceStable(sortedPicks, func(i, j int) bool { return sortedPicks[i].Pick < sortedPicks[j].Pick })
+
+	points := make([]float64, len(sortedPicks))
+	for i, pick := range sortedPicks {
+		player := byKey[pick.PlayerKey]
+		player.Stats = append([]models.Stat(nil), player.Stats...)
+		_, total, err := GetLeaguePlayerStats(statModifiers, player)
+		if err == nil {
+			points[i] = total
+		}
+	}
+	ranked := append([]float64(nil), points...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(ranked)))
+
+	grades := make(map[string]*models.ManagerDraftGrade)
+	var order []string
+	best := make(map[string]models.GradedPick)
+	worst := make(map[string]models.GradedPick)
+
+	for i, pick := range sortedPicks {
+		player := byKey[pick.PlayerKey]
+		valueRank := 1 + sort.Search(len(ranked), func(r int) bool { return ranked[r] <= points[i] })
+
+		graded := models.GradedPick{
+			Pick:           pick.Pick,
+			Round:          pick.Round,
+			TeamKey:        pick.TeamKey,
+			TeamName:       teamNames[pick.TeamKey],
+			PlayerKey:      pick.PlayerKey,
+			PlayerName:     player.Name.FullName,
+			Position:       player.DisplayPosition,
+			Points:         utils.RoundFloat(points[i], 2),
+			ValueRank:      valueRank,
+			ExpectedPoints: utils.RoundFloat(ranked[i], 2),
+			Surplus:        utils.RoundFloat(points[i]-ranked[i], 2),
+		}
+		if numTeams > 0 && i+1-valueRank >= numTeams {
+			graded.Verdict = draftSteal
+		} else if numTeams > 0 && valueRank-(i+1) >= numTeams {
+			graded.Verdict = draftBust
+		}
+		analysis.Picks = append(analysis.Picks, graded)
+
+		grade, ok := grades[pick.TeamKey]
+		if !ok {
+			grade = &models.ManagerDraftGrade{TeamKey: pick.TeamKey, TeamName: teamNames[pick.TeamKey]}
+			grades[pick.TeamKey] = grade
+			order = append(order, pick.TeamKey)
+			best[pick.TeamKey], worst[pick.TeamKey] = graded, graded
+		}
+		grade.Picks++
+		grade.Points += points[i]
+		grade.ExpectedPoints += ranked[i]
+		switch graded.Verdict {
+		case draftSteal:
+			grade.Steals++
+			analysis.Steals = append(analysis.Steals, graded)
+		case draftBust:
+			grade.Busts++
+			analysis.Busts = append(analysis.Busts, graded)
+		}
+		if graded.Surplus > best[pick.TeamKey].Surplus {
+			best[pick.TeamKey] = graded
+		}
+		if graded.Surplus < worst[pick.TeamKey].Surplus {
+			worst[pick.TeamKey] = graded
+		}
+	}
+
+	surpluses := make([]float64, 0, len(order))
+	for _, teamKey := range order {
+		grade := grades[teamKey]
+		grade.Surplus = grade.Points - grade.ExpectedPoints
+		surpluses = append(surpluses, grade.Surplus)
+	}
+	mean, deviation := meanAndDeviation(surpluses)
+
+	for _, teamKey := range order {
+		grade := grades[teamKey]
+		grade.Grade = draftGrade(grade.Surplus, mean, deviation)
+		grade.BestPick = best[teamKey].PlayerName
+		grade.WorstPick = worst[teamKey].PlayerName
+		grade.Points = utils.RoundFloat(grade.Points, 2)
+		grade.ExpectedPoints = utils.RoundFloat(grade.ExpectedPoints, 2)
+		grade.Surplus = utils.RoundFloat(grade.Surplus, 2)
+		analysis.Managers = append(analysis.Managers, *grade)
+	}
+	sort.SliceStable(analysis.Managers, func(i, j int) bool { return analysis.Managers[i].Surplus > analysis.Managers[j].Surplus })
+
+	sort.SliceStable(analysis.Steals, func(i, j int) bool { return analysis.Steals[i].Surplus > analysis.Steals[j].Surplus })
+	sort.SliceStable(analysis.Busts, func(i, j int) bool { return analysis.Busts[i].Surplus < analysis.Busts[j].Surplus })
+	if len(analysis.Steals) > draftLeaders {
+		analysis.Steals = analysis.Steals[:draftLeaders]
+	}
+	if len(analysis.Busts) > draftLeaders {
+		analysis.Busts = analysis.Busts[:draftLeaders]
+	}
+
+	return analysis
+}
+
+// draftGrade letters a manager's surplus by its distance from the league mean in standard deviations
+func draftGrade(surplus, mean, deviation float64) string {
+	if deviation == 0 {
+		return "C"
+	}
+
+	z := (surplus - mean) / deviation
+	switch {
+	case z >= 1:
+		return "A"
+	case z >= 0.33:
+		return "B"
+	case z > -0.33:
+		return "C"
+	case z > -1:
+		return "D"
+	}
+	return "F"
+}
+
+func meanAndDeviation(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	mean := 0.0
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(len(values))
+
+	variance := 0.0
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	DefaultPointsWindow = 5
+	streakThreshold     = 0.25 // Rolling average this far above or below the season average
+)
+
+// GetPlayerPointsSeries scores each game in a player's NHL game log with the league's modifiers
+func GetPlayerPointsSeries(sessionId, leagueId, playerKey, season string, window int) (*models.PlayerPointsSeries, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	nhlId, err := nhlPlayerID(playerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if season == "" {
+		season = utils.GetCurrentNhlSeason()
+	}
+
+	games, err := GetPlayerGameStatsNHL(nhlId, season, models.RegularSeasonGame)
+	if err != nil {
+		return nil, err
+	}
+
+	series := BuildPointsSeries(settings.StatModifiers, games, window)
+	series.PlayerKey = playerKey
+	series.NHLPlayerID = nhlId
+	series.Season = season
+	return &series, nil
+}
+
+// BuildPointsSeries returns per-game points with a rolling average. A run of games whose rolling
+// average sits at least streakThreshold above or below the season average is a hot or cold streak.
+func BuildPointsSeries(statModifiers []models.StatModifier, games []*models.PlayerGameStat, window int) models.PlayerPointsSeries {
+	series := models.PlayerPointsSeries{
+		Window:      window,
+		CurrentForm: "neutral",
+		Games:       []models.GamePoints{},
+		Streaks:     []models.PointsStreak{},
+	}
+
+	sorted := append([]*models.PlayerGameStat(nil), games...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].GameDate < sorted[j].GameDate })
+
+	modifiers := make(map[string]float64)
+	for _, modifier := range statModifiers {
+		modifiers[modifier.StatID] = modifier.Value
+	}
+	statNames := utils.GetStatIDToNameMap()
+
+	points := make([]float64, len(sorted))
+	for i, game := range sorted {
+		gamePoints := models.GamePoints{
+			GameID:    game.GameID,
+			GameDate:  game.GameDate,
+			Opponent:  game.OpponentAbbrev,
+			HomeRoad:  game.HomeRoadFlag,
+			Breakdown: map[string]float64{},
+		}
+
+		for _, stat := range GameLogStats(game) {
+			modifier, ok := modifiers[stat.StatID]
+			if !ok {
+				continue
+			}
+			value, _ := strconv.ParseFloat(stat.Value, 64)
+			if value == 0 {
+				continue
+			}
+			gamePoints.Breakdown[statNames[stat.StatID]] = utils.RoundFloat(value*modifier, 2)
+			points[i] += value * modifier
+		}
+
+		series.TotalPoints += points[i]
+		gamePoints.Points = utils.RoundFloat(points[i], 2)
+		series.Games = append(series.Games, gamePoints)
+	}
+
+	if len(points) == 0 {
+		return series
+	}
+
+	seasonAverage := series.TotalPoints / float64(len(points))
+	series.TotalPoints = utils.RoundFloat(series.TotalPoints, 2)
+	series.SeasonAverage = utils.RoundFloat(seasonAverage, 2)
+
+	forms := make([]string, len(points))
+	rolling := 0.0
+	for i := range points {
+		rolling += points[i]
+		if i >= window {
+			rolling -= points[i-window]
+		}
+		size := window
+		if i+1 < window {
+			size = i + 1
+		}
+		average := rolling / float64(size)
+		series.Games[i].RollingAverage = utils.RoundFloat(average, 2)
+
+		// Streaks need a full window to avoid flagging a single game
+		forms[i] = "neutral"
+		if i+1 >= window && seasonAverage > 0 {
+			if average >= seasonAverage*(1+streakThreshold) {
+				forms[i] = "hot"
+			} else if average <= seasonAverage*(1-streakThreshold) {
+				forms[i] = "cold"
+			}
+		}
+	}
+
+	last := len(points) - 1
+	series.RecentAverage = series.Games[last].RollingAverage
+	series.CurrentForm = forms[last]
+
+	for start := 0; start < len(forms); {
+		end := start
+		for end+1 < len(forms) && forms[end+1] == forms[start] {
+			end++
+		}
+		if forms[start] != "neutral" {
+			total := 0.0
+			for i := start; i <= end; i++ {
+				total += points[i]
+			}
+			series.Streaks = append(series.Streaks, models.PointsStreak{
+				Type:          forms[start],
+				StartDate:     sorted[start].GameDate,
+				EndDate:       sorted[end].GameDate,
+				Games:         end - start + 1,
+				AveragePoints: utils.RoundFloat(total/float64(end-start+1), 2),
+			})
+		}
+		start = end + 1
+	}
+
+	return series
+}
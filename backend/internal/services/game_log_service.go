@@ -0,0 +1,259 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	DefaultBackfillSeasons = 3
+	MaxBackfillSeasons     = 10
+
+	backfillRequestDelay = 250 * time.Millisecond // Spaces out NHL API calls
+)
+
+// ErrBackfillRunning is returned when a backfill is started while another is still running
+var ErrBackfillRunning = errors.New("a game log backfill is already running")
+
+// backfill is the latest game log backfill; one runs at a time
+var backfill struct {
+	sync.Mutex
+	state *models.GameLogBackfill
+}
+
+// StartGameLogBackfill starts fetching regular season and playoff game logs for every mapped player
+// over the given number of seasons before the current one. The fetch runs in the background, one
+// throttled NHL request at a time, and its progress is read with GetGameLogBackfill.
+func StartGameLogBackfill(seasonCount int) (*models.GameLogBackfill, error) {
+	backfill.Lock()
+	defer backfill.Unlock()
+	if backfill.state != nil && backfill.state.Status == models.BackfillRunning {
+		return nil, ErrBackfillRunning
+	}
+
+	mappings, err := repositories.GetPlayerIDMappings()
+	if err != nil {
+		return nil, err
+	}
+	var playerIds []string
+	for _, mapping := range mappings {
+		if mapping.NHLPlayerID != "" {
+			playerIds = append(playerIds, mapping.NHLPlayerID)
+		}
+	}
+
+	backfill.state = &models.GameLogBackfill{
+		Status:    models.BackfillRunning,
+		Seasons:   utils.PreviousNhlSeasons(utils.GetCurrentNhlSeason(), seasonCount),
+		Players:   len(playerIds),
+		Failures:  []string{},
+		StartedAt: time.Now(),
+	}
+	go runGameLogBackfill(playerIds, backfill.state.Seasons)
+
+	return snapshotBackfill(), nil
+}
+
+// GetGameLogBackfill reports the progress of the latest backfill
+func GetGameLogBackfill() (*models.GameLogBackfill, error) {
+	backfill.Lock()
+	defer backfill.Unlock()
+	if backfill.state == nil {
+		return nil, utils.NewNotFoundError("no game log backfill has been started")
+	}
+	return snapshotBackfill(), nil
+}
+
+func runGameLogBackfill(playerIds, seasons []string) {
+	for _, playerId := range playerIds {
+		for _, season := range seasons {
+			for _, gameType := range []int{models.RegularSeasonGame, models.PlayoffGame} {
+				games, err := GetPlayerGameStatsNHL(playerId, season, gameType)
+
+				backfill.Lock()
+				if err != nil {
+					log.Printf("Failed to backfill game log for player %s season %s: %v", playerId, season, err)
+					backfill.state.Failures = append(backfill.state.Failures, fmt.Sprintf("%s %s/%d", playerId, season, gameType))
+				} else {
+					backfill.state.Games += len(games)
+				}
+				backfill.Unlock()
+
+				time.Sleep(backfillRequestDelay)
+			}
+		}
+
+		backfill.Lock()
+		backfill.state.PlayersDone++
+		backfill.Unlock()
+	}
+
+	backfill.Lock()
+	finished := time.Now()
+	backfill.state.Status = models.BackfillCompleted
+	backfill.state.FinishedAt = &finished
+	log.Printf("Game log backfill finished: %d players, %d games, %d failures", backfill.state.Players, backfill.state.Games, len(backfill.state.Failures))
+	backfill.Unlock()
+}
+
+// snapshotBackfill copies the backfill state so callers can read it while the job runs. The caller
+// holds the lock.
+func snapshotBackfill() *models.GameLogBackfill {
+	snapshot := *backfill.state
+	snapshot.Seasons = append([]string{}, backfill.state.Seasons...)
+	snapshot.Failures = append([]string{}, backfill.state.Failures...)
+	return &snapshot
+}
+
+// GetPlayerCareer aggregates every stored game of an NHL player by season and game type
+func GetPlayerCareer(playerId string) (*models.PlayerCareer, error) {
+	games, err := repositories.GetPlayerGameStatsDB(playerId)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("no stored games for player %s", playerId))
+	}
+
+	career := AggregateGameLogs(games)
+	career.NHLPlayerID = playerId
+	return &career, nil
+}
+
+// GetPlayerSeasonTotals returns a player's stored totals per season for one game type
+func GetPlayerSeasonTotals(playerId string, gameType int) ([]models.GameLogTotals, error) {
+	career, err := GetPlayerCareer(playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons := []models.GameLogTotals{}
+	for _, totals := range career.Seasons {
+		if totals.GameType == gameType {
+			seasons = append(seasons, totals)
+		}
+	}
+	return seasons, nil
+}
+
+// AggregateGameLogs totals game log lines per season and game type and over the whole career
+func AggregateGameLogs(games []*models.PlayerGameStat) models.PlayerCareer {
+	career := models.PlayerCareer{
+		RegularSeason: models.GameLogTotals{GameType: models.RegularSeasonGame},
+		Playoffs:      models.GameLogTotals{GameType: models.PlayoffGame},
+		Seasons:       []models.GameLogTotals{},
+	}
+
+	sorted := append([]*models.PlayerGameStat(nil), games...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].GameDate < sorted[j].GameDate })
+
+	regularTOI, playoffTOI := 0.0, 0.0
+	seasonTOI := make(map[string]float64)
+	bySeason := make(map[string]*models.GameLogTotals)
+	var order []string
+
+	for _, game := range sorted {
+		season := gameSeason(game)
+		gameType := gameLogType(game)
+		key := fmt.Sprintf("%d/%d", season, gameType)
+
+		totals, ok := bySeason[key]
+		if !ok {
+			totals = &models.GameLogTotals{Season: season, GameType: gameType}
+			bySeason[key] = totals
+			order = append(order, key)
+		}
+		addGameToTotals(totals, game)
+		totals.Team = game.TeamAbbrev
+		seasonTOI[key] += parseTOI(game.TOI)
+
+		if gameType == models.PlayoffGame {
+			addGameToTotals(&career.Playoffs, game)
+			playoffTOI += parseTOI(game.TOI)
+		} else {
+			addGameToTotals(&career.RegularSeason, game)
+			regularTOI += parseTOI(game.TOI)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := bySeason[order[i]], bySeason[order[j]]
+		if a.Season != b.Season {
+			return a.Season < b.Season
+		}
+		return a.GameType < b.GameType
+	})
+	for _, key := range order {
+		totals := bySeason[key]
+		finishTotals(totals, seasonTOI[key])
+		career.Seasons = append(career.Seasons, *totals)
+	}
+	finishTotals(&career.RegularSeason, regularTOI)
+	finishTotals(&career.Playoffs, playoffTOI)
+
+	return career
+}
+
+func addGameToTotals(totals *models.GameLogTotals, game *models.PlayerGameStat) {
+	totals.GamesPlayed++
+	totals.Goals += game.Goals
+	totals.Assists += game.Assists
+	totals.Points += game.Points
+	totals.PlusMinus += game.PlusMinus
+	totals.PIM += game.PIM
+	totals.PowerPlayPoints += game.PowerPlayPoints
+	totals.ShorthandedPoints += game.ShorthandedPoints
+	totals.GameWinningGoals += game.GameWinningGoals
+	totals.Shots += game.Shots
+
+	totals.GamesStarted += game.GamesStarted
+	totals.Shutouts += game.Shutouts
+	totals.Saves += game.Saves
+	totals.GoalsAgainst += game.GoalsAgainst
+	totals.ShotsAgainst += game.ShotsAgainst
+	switch game.Decision {
+	case "W":
+		totals.Wins++
+	case "L":
+		totals.Losses++
+	case "O":
+		totals.OvertimeLosses++
+	}
+}
+
+func finishTotals(totals *models.GameLogTotals, toi float64) {
+	if totals.GamesPlayed > 0 {
+		totals.PointsPerGame = utils.RoundFloat(float64(totals.Points)/float64(totals.GamesPlayed), 3)
+		totals.TOIPerGame = utils.RoundFloat(toi/float64(totals.GamesPlayed), 2)
+	}
+	if totals.ShotsAgainst > 0 {
+		totals.SavePct = utils.RoundFloat(float64(totals.Saves)/float64(totals.ShotsAgainst), 3)
+	}
+}
+
+// gameSeason falls back to the game date for lines stored before the season was recorded
+func gameSeason(game *models.PlayerGameStat) int {
+	if game.Season != 0 {
+		return game.Season
+	}
+	season, _ := strconv.Atoi(utils.NhlSeasonForDate(utils.ParseDate(game.GameDate)))
+	return season
+}
+
+// gameLogType treats lines stored before the game type was recorded as regular season, the only
+// type fetched back then
+func gameLogType(game *models.PlayerGameStat) int {
+	if game.GameType == 0 {
+		return models.RegularSeasonGame
+	}
+	return game.GameType
+}
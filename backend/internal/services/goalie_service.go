@@ -0,0 +1,189 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	goalieRecentTeamGames = 10
+	// Starters rarely play both halves of a back-to-back, so the first game's likely starter loses
+	// most of the second and the backup picks up half of what is left
+	backToBackStarterFactor = 0.4
+	backToBackBackupBoost   = 0.5
+)
+
+// GetGoalieOutlook projects a goalie's starts and fantasy points over the next days
+func GetGoalieOutlook(sessionId, leagueId, playerKey string, days int) (*models.GoalieOutlook, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	nhlId, err := nhlPlayerID(playerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := GetPlayerGameStatsNHL(nhlId, utils.GetCurrentNhlSeason(), models.RegularSeasonGame)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("no games played this season by %s", playerKey))
+	}
+	team := latestTeam(games)
+
+	now := time.Now()
+	today := now.Format(lineupDateLayout)
+	schedule, err := repositories.GetScheduleGamesBetween(currentSeasonStart(), now.AddDate(0, 0, days-1).Format(lineupDateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	var played, upcoming []models.ScheduleGame
+	for _, game := range schedule {
+		if game.HomeTeamAbbrev != team && game.AwayTeamAbbrev != team {
+			continue
+		}
+		if game.GameDate < today {
+			played = append(played, game)
+		} else {
+			upcoming = append(upcoming, game)
+		}
+	}
+
+	outlook := BuildGoalieOutlook(settings.StatModifiers, team, games, played, upcoming)
+	outlook.PlayerKey = playerKey
+	outlook.NHLPlayerID = nhlId
+	return &outlook, nil
+}
+
+// BuildGoalieOutlook estimates how likely a goalie is to start each upcoming team game from their
+// share of the team's recent starts, adjusted for back-to-backs, and projects points per start.
+func BuildGoalieOutlook(statModifiers []models.StatModifier, team string, games []*models.PlayerGameStat, teamPlayed, teamUpcoming []models.ScheduleGame) models.GoalieOutlook {
+	outlook := models.GoalieOutlook{
+		Team:     team,
+		PerStart: []models.ProjectedStat{},
+		Upcoming: []models.GoalieStartProjection{},
+	}
+
+	startedOn := make(map[string]bool)
+	var starts []*models.PlayerGameStat
+	shotsAgainst := 0
+	for _, game := range games {
+		outlook.GamesPlayed++
+		outlook.Shutouts += game.Shutouts
+		outlook.Saves += game.Saves
+		outlook.GoalsAgainst += game.GoalsAgainst
+		shotsAgainst += game.ShotsAgainst
+		switch game.Decision {
+		case "W":
+			outlook.Wins++
+		case "L":
+			outlook.Losses++
+		case "O":
+			outlook.OvertimeLosses++
+		}
+		if game.GamesStarted > 0 {
+			outlook.Starts++
+			startedOn[game.GameDate] = true
+			starts = append(starts, game)
+		}
+	}
+	if shotsAgainst > 0 {
+		outlook.SavePct = utils.RoundFloat(float64(outlook.Saves)/float64(shotsAgainst), 3)
+	}
+
+	sort.Slice(teamPlayed, func(i, j int) bool { return teamPlayed[i].GameDate < teamPlayed[j].GameDate })
+	recent := teamPlayed
+	if len(recent) > goalieRecentTeamGames {
+		recent = recent[len(recent)-goalieRecentTeamGames:]
+	}
+	outlook.RecentTeamGames = len(recent)
+	for _, game := range recent {
+		if startedOn[game.GameDate] {
+			outlook.RecentStarts++
+		}
+	}
+	for i := len(teamPlayed) - 1; i >= 0 && startedOn[teamPlayed[i].GameDate]; i-- {
+		outlook.ConsecutiveStart++
+	}
+	if outlook.RecentTeamGames > 0 {
+		outlook.StartShare = utils.RoundFloat(float64(outlook.RecentStarts)/float64(outlook.RecentTeamGames), 3)
+	}
+
+	perStart := BuildPlayerProjection(statModifiers, starts, 0)
+	outlook.PointsPerStart = perStart.PointsPerGame
+	for _, stat := range perStart.Stats {
+		if isGoalieStat(stat.StatID) {
+			stat.RestOfSeason = 0
+			outlook.PerStart = append(outlook.PerStart, stat)
+		}
+	}
+
+	sort.Slice(teamUpcoming, func(i, j int) bool { return teamUpcoming[i].GameDate < teamUpcoming[j].GameDate })
+	previousDate := ""
+	if len(teamPlayed) > 0 {
+		previousDate = teamPlayed[len(teamPlayed)-1].GameDate
+	}
+	previousLikelihood := 0.0
+	if previousDate != "" && startedOn[previousDate] {
+		previousLikelihood = 1
+	}
+
+	for _, game := range teamUpcoming {
+		likelihood := outlook.StartShare
+		backToBack := previousDate != "" && isNextDay(previousDate, game.GameDate)
+		if backToBack {
+			if previousLikelihood >= 0.5 {
+				likelihood *= backToBackStarterFactor
+			} else {
+				likelihood += (1 - likelihood) * backToBackBackupBoost
+			}
+		}
+
+		opponent := "vs " + game.AwayTeamAbbrev
+		if game.AwayTeamAbbrev == team {
+			opponent = "@ " + game.HomeTeamAbbrev
+		}
+
+		outlook.Upcoming = append(outlook.Upcoming, models.GoalieStartProjection{
+			Date:            game.GameDate,
+			Opponent:        opponent,
+			BackToBack:      backToBack,
+			StartLikelihood: utils.RoundFloat(likelihood, 3),
+			ProjectedPoints: utils.RoundFloat(likelihood*outlook.PointsPerStart, 2),
+		})
+		outlook.ExpectedStarts += likelihood
+		outlook.ProjectedPoints += likelihood * outlook.PointsPerStart
+
+		previousDate = game.GameDate
+		previousLikelihood = likelihood
+	}
+
+	outlook.ExpectedStarts = utils.RoundFloat(outlook.ExpectedStarts, 2)
+	outlook.ProjectedPoints = utils.RoundFloat(outlook.ProjectedPoints, 2)
+	return outlook
+}
+
+func isGoalieStat(statID string) bool {
+	switch statID {
+	case "19", "21", "22", "25", "27", "28":
+		return true
+	}
+	return false
+}
+
+func isNextDay(previous, next string) bool {
+	previousDay, err := time.Parse(lineupDateLayout, previous)
+	if err != nil {
+		return false
+	}
+	return previousDay.AddDate(0, 0, 1).Format(lineupDateLayout) == next
+}
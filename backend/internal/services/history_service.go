@@ -0,0 +1,380 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const maxLeagueChain = 30 // Seasons followed from one league, guarding against a renew loop
+
+// ArchiveLeagueHistory follows the league's renew chain back and forward and archives every
+// season's settings, final standings and matchups. Finished seasons already archived are not
+// fetched again, and seasons the user cannot access are logged and skipped.
+func ArchiveLeagueHistory(sessionId, leagueKey string) ([]models.SeasonSummary, error) {
+	visited := make(map[string]bool)
+	var seasons []models.LeagueSeason
+	queue := []string{leagueKey}
+	for len(queue) > 0 && len(visited) < maxLeagueChain {
+		key := queue[0]
+		queue = queue[1:]
+		if key == "" || visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		season, err := archiveLeagueSeason(sessionId, key)
+		if err != nil {
+			if key == leagueKey {
+				return nil, err
+			}
+			log.Printf("Failed to archive league %s: %v", key, err)
+			continue
+		}
+		seasons = append(seasons, *season)
+		queue = append(queue, season.PreviousLeagueKey, season.NextLeagueKey)
+	}
+
+	keys := make([]string, 0, len(seasons))
+	for _, season := range seasons {
+		keys = append(keys, season.LeagueKey)
+	}
+	teams, err := repositories.GetSeasonTeams(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildLeagueHistory(seasons, teams, nil, "").Seasons, nil
+}
+
+// GetLeagueHistory reports a league chain's seasons, manager careers and all-time head-to-head
+// records from the archive, archiving the chain first when the league has never been archived.
+// A manager Id limits the head-to-head records to that manager's.
+func GetLeagueHistory(sessionId, leagueKey, managerId string) (*models.LeagueHistory, error) {
+	stored, err := repositories.GetLeagueSeason(leagueKey)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		if _, err := ArchiveLeagueHistory(sessionId, leagueKey); err != nil {
+			return nil, err
+		}
+	}
+
+	seasons, err := storedLeagueChain(leagueKey)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(seasons))
+	for _, season := range seasons {
+		keys = append(keys, season.LeagueKey)
+	}
+
+	teams, err := repositories.GetSeasonTeams(keys)
+	if err != nil {
+		return nil, err
+	}
+	matchups, err := repositories.GetSeasonMatchups(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	history := BuildLeagueHistory(seasons, teams, matchups, managerId)
+	history.LeagueKey = leagueKey
+	return &history, nil
+}
+
+// BuildLeagueHistory ties archived teams to their managers across seasons. Finishes, playoff
+// appearances and championships only count once a season is finished.
+func BuildLeagueHistory(seasons []models.LeagueSeason, teams []models.SeasonTeam, matchups []models.SeasonMatchup, managerId string) models.LeagueHistory {
+	history := models.LeagueHistory{
+		Seasons:    []models.SeasonSummary{},
+		Managers:   []models.ManagerCareer{},
+		HeadToHead: []models.HeadToHeadRecord{},
+	}
+
+	seasonsByKey := make(map[string]models.LeagueSeason)
+	for _, season := range seasons {
+		seasonsByKey[season.LeagueKey] = season
+	}
+	teamsByKey := make(map[string]models.SeasonTeam)
+	for _, team := range teams {
+		teamsByKey[team.TeamKey] = team
+	}
+
+	for _, season := range seasons {
+		summary := models.SeasonSummary{
+			Season:     season.Season,
+			LeagueKey:  season.LeagueKey,
+			Name:       season.Name,
+			NumTeams:   season.NumTeams,
+			IsFinished: season.IsFinished,
+		}
+		if champion, ok := teamsByKey[season.ChampionTeamKey]; ok {
+			summary.ChampionTeam = champion.Name
+			summary.ChampionManager = champion.ManagerName
+		}
+		history.Seasons = append(history.Seasons, summary)
+	}
+	sort.SliceStable(history.Seasons, func(i, j int) bool { return history.Seasons[i].Season > history.Seasons[j].Season })
+
+	// Oldest season first so the latest nickname wins and team names read in order
+	ordered := append([]models.SeasonTeam(nil), teams...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Season < ordered[j].Season })
+
+	careers := make(map[string]*models.ManagerCareer)
+	finishes := make(map[string][]int)
+	var order []string
+	for _, team := range ordered {
+		if team.ManagerID == "" {
+			continue
+		}
+		career, ok := careers[team.ManagerID]
+		if !ok {
+			career = &models.ManagerCareer{ManagerID: team.ManagerID, TeamNames: []string{}}
+			careers[team.ManagerID] = career
+			order = append(order, team.ManagerID)
+		}
+
+		career.ManagerName = team.ManagerName
+		career.Seasons++
+		career.Wins += team.Wins
+		career.Losses += team.Losses
+		career.Ties += team.Ties
+		career.PointsFor += team.PointsFor
+		career.PointsAgainst += team.PointsAgainst
+		if len(career.TeamNames) == 0 || career.TeamNames[len(career.TeamNames)-1] != team.Name {
+			career.TeamNames = append(career.TeamNames, team.Name)
+		}
+
+		season := seasonsByKey[team.LeagueKey]
+		if !season.IsFinished {
+			continue
+		}
+		if team.PlayoffSeed > 0 {
+			career.PlayoffAppearances++
+		}
+		if season.ChampionTeamKey == team.TeamKey {
+			career.Championships++
+		}
+		if team.Rank > 0 {
+			finishes[team.ManagerID] = append(finishes[team.ManagerID], team.Rank)
+		}
+	}
+
+	records := make(map[[2]string]*models.HeadToHeadRecord)
+	var pairs [][2]string
+	record := func(team, opponent models.SeasonTeam) *models.HeadToHeadRecord {
+		pair := [2]string{team.ManagerID, opponent.ManagerID}
+		if _, ok := records[pair]; !ok {
+			records[pair] = &models.HeadToHeadRecord{ManagerID: team.ManagerID, OpponentID: opponent.ManagerID}
+			pairs = append(pairs, pair)
+		}
+		return records[pair]
+	}
+
+	for _, matchup := range matchups {
+		if matchup.IsConsolation {
+			continue
+		}
+		team, ok := teamsByKey[matchup.TeamKey]
+		opponent, found := teamsByKey[matchup.OpponentKey]
+		if !ok || !found || team.ManagerID == "" || opponent.ManagerID == "" || team.ManagerID == opponent.ManagerID {
+			continue
+		}
+
+		sides := []struct {
+			team, opponent  models.SeasonTeam
+			scored, allowed float64
+		}{
+			{team, opponent, matchup.TeamPoints, matchup.OpponentPoints},
+			{opponent, team, matchup.OpponentPoints, matchup.TeamPoints},
+		}
+		for _, side := range sides {
+			headToHead := record(side.team, side.opponent)
+			headToHead.Meetings++
+			headToHead.PointsFor += side.scored
+			headToHead.PointsAgainst += side.allowed
+			if matchup.IsPlayoffs {
+				headToHead.PlayoffMeetings++
+			}
+
+			career := careers[side.team.ManagerID]
+			switch {
+			case matchup.IsTied:
+				headToHead.Ties++
+			case matchup.WinnerTeamKey == side.team.TeamKey:
+				headToHead.Wins++
+				if matchup.IsPlayoffs {
+					career.PlayoffWins++
+				}
+			default:
+				headToHead.Losses++
+				if matchup.IsPlayoffs {
+					career.PlayoffLosses++
+				}
+			}
+		}
+	}
+
+	for _, id := range order {
+		career := careers[id]
+		if games := career.Wins + career.Losses + career.Ties; games > 0 {
+			career.WinPercentage = utils.RoundFloat((float64(career.Wins)+0.5*float64(career.Ties))/float64(games), 3)
+		}
+		if ranks := finishes[id]; len(ranks) > 0 {
+			total := 0
+			career.BestFinish = ranks[0]
+			for _, rank := range ranks {
+				total += rank
+				if rank < career.BestFinish {
+					career.BestFinish = rank
+				}
+			}
+			career.AverageFinish = utils.RoundFloat(float64(total)/float64(len(ranks)), 2)
+		}
+		career.PointsFor = utils.RoundFloat(career.PointsFor, 2)
+		career.PointsAgainst = utils.RoundFloat(career.PointsAgainst, 2)
+		history.Managers = append(history.Managers, *career)
+	}
+	sort.SliceStable(history.Managers, func(i, j int) bool {
+		a, b := history.Managers[i], history.Managers[j]
+		if a.Championships != b.Championships {
+			return a.Championships > b.Championships
+		}
+		return a.WinPercentage > b.WinPercentage
+	})
+
+	for _, pair := range pairs {
+		headToHead := records[pair]
+		if managerId != "" && headToHead.ManagerID != managerId {
+			continue
+		}
+		headToHead.ManagerName = careers[headToHead.ManagerID].ManagerName
+		headToHead.OpponentName = careers[headToHead.OpponentID].ManagerName
+		headToHead.PointsFor = utils.RoundFloat(headToHead.PointsFor, 2)
+		headToHead.PointsAgainst = utils.RoundFloat(headToHead.PointsAgainst, 2)
+		history.HeadToHead = append(history.HeadToHead, *headToHead)
+	}
+	sort.SliceStable(history.HeadToHead, func(i, j int) bool {
+		a, b := history.HeadToHead[i], history.HeadToHead[j]
+		if a.ManagerName != b.ManagerName {
+			return a.ManagerName < b.ManagerName
+		}
+		return a.OpponentName < b.OpponentName
+	})
+
+	return history
+}
+
+// archiveLeagueSeason fetches and stores one season. An unfinished season only fetches the
+// weeks after the last archived one.
+func archiveLeagueSeason(sessionId, leagueKey string) (*models.LeagueSeason, error) {
+	stored, err := repositories.GetLeagueSeason(leagueKey)
+	if err != nil {
+		return nil, err
+	}
+	if stored != nil && stored.IsFinished {
+		return stored, nil
+	}
+
+	settingsResponse, err := AuthHttpXMLRequest(sessionId, fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/settings", leagueKey))
+	if err != nil {
+		return nil, err
+	}
+	season, err := MapLeagueSeason(settingsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map league season: %w", err)
+	}
+
+	standingsResponse, err := AuthHttpXMLRequest(sessionId, fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/standings", leagueKey))
+	if err != nil {
+		return nil, err
+	}
+	teams, err := MapSeasonTeams(standingsResponse, season.Season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map season teams: %w", err)
+	}
+
+	firstWeek := season.StartWeek
+	if stored != nil {
+		archived, err := repositories.GetSeasonMatchups([]string{leagueKey})
+		if err != nil {
+			return nil, err
+		}
+		for _, matchup := range archived {
+			if matchup.Week >= firstWeek {
+				firstWeek = matchup.Week + 1
+			}
+		}
+	}
+	lastWeek := season.EndWeek
+	if !season.IsFinished && season.CurrentWeek < lastWeek {
+		lastWeek = season.CurrentWeek
+	}
+
+	var matchups []models.SeasonMatchup
+	for week := firstWeek; week <= lastWeek; week++ {
+		response, err := AuthHttpXMLRequest(sessionId, fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/scoreboard;week=%d", leagueKey, week))
+		if err != nil {
+			return nil, err
+		}
+		weekly, err := MapScoreboardMatchups(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map week %d scoreboard: %w", week, err)
+		}
+		matchups = append(matchups, weekly...)
+	}
+
+	if season.IsFinished {
+		season.ChampionTeamKey = SeasonChampion(teams)
+	}
+	if err := repositories.SaveLeagueSeason(season, teams, matchups); err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// SeasonChampion returns the team that finished first; Yahoo's final ranks follow the playoffs
+func SeasonChampion(teams []models.SeasonTeam) string {
+	for _, team := range teams {
+		if team.Rank == 1 {
+			return team.TeamKey
+		}
+	}
+	return ""
+}
+
+// storedLeagueChain walks the archived renew links from a league in both directions
+func storedLeagueChain(leagueKey string) ([]models.LeagueSeason, error) {
+	var seasons []models.LeagueSeason
+	visited := make(map[string]bool)
+	queue := []string{leagueKey}
+	for len(queue) > 0 && len(visited) < maxLeagueChain {
+		key := queue[0]
+		queue = queue[1:]
+		if key == "" || visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		season, err := repositories.GetLeagueSeason(key)
+		if err != nil {
+			return nil, err
+		}
+		if season == nil {
+			continue
+		}
+		seasons = append(seasons, *season)
+		queue = append(queue, season.PreviousLeagueKey, season.NextLeagueKey)
+	}
+
+	if len(seasons) == 0 {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("league %s has not been archived", leagueKey))
+	}
+	return seasons, nil
+}
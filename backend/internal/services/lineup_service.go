@@ -0,0 +1,295 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const lineupDateLayout = "2006-01-02"
+
+// OptimizeTeamLineup picks the best daily lineups for a fantasy team between two dates using
+// each player's season points per game under the league's stat modifiers.
+func OptimizeTeamLineup(sessionId, teamId, startDate, endDate string) (*models.LineupOptimization, error) {
+	start, err := time.Parse(lineupDateLayout, startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %s: %w", startDate, err)
+	}
+	end, err := time.Parse(lineupDateLayout, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date %s: %w", endDate, err)
+	}
+
+	leagueId, err := utils.TeamtoLeagueId(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err := GetFantasyTeamRoster(sessionId, teamId, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	perGame := make(map[string]float64)
+	for _, player := range roster.Players {
+		points, err := playerPointsPerGame(sessionId, settings.StatModifiers, player.PlayerKey)
+		if err != nil {
+			log.Printf("Failed to project player %s: %v", player.PlayerKey, err)
+			continue
+		}
+		perGame[player.PlayerKey] = points
+	}
+
+	games, err := repositories.GetScheduleGamesBetween(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	opponents := opponentsByDate(games)
+
+	result := &models.LineupOptimization{
+		TeamKey:   teamId,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Days:      []models.DailyLineup{},
+	}
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(lineupDateLayout)
+		lineup := OptimizeLineupForDay(date, settings.RosterPositions, roster.Players, perGame, opponents[date])
+
+		result.ProjectedPoints += lineup.ProjectedPoints
+		result.CurrentPoints += lineup.CurrentPoints
+		result.Days = append(result.Days, lineup)
+	}
+
+	result.ProjectedPoints = utils.RoundFloat(result.ProjectedPoints, 2)
+	result.CurrentPoints = utils.RoundFloat(result.CurrentPoints, 2)
+	result.Gain = utils.RoundFloat(result.ProjectedPoints-result.CurrentPoints, 2)
+
+	return result, nil
+}
+
+// playerPointsPerGame converts a player's season stats to league points per game
+func playerPointsPerGame(sessionId string, statModifiers []models.StatModifier, playerKey string) (float64, error) {
+	player, err := GetPlayerStats(sessionId, playerKey)
+	if err != nil {
+		return 0, err
+	}
+
+	return seasonPointsPerGame(statModifiers, *player)
+}
+
+// seasonPointsPerGame divides a player's league points by games played
+func seasonPointsPerGame(statModifiers []models.StatModifier, player models.Player) (float64, error) {
+	gamesPlayed := 0.0
+	for _, stat := range player.Stats {
+		// Goalies are credited with games started rather than games played
+		if stat.StatID == "0" || (stat.StatID == "21" && gamesPlayed == 0) {
+			if value, err := strconv.ParseFloat(stat.Value, 64); err == nil && value > 0 {
+				gamesPlayed = value
+			}
+		}
+	}
+	if gamesPlayed == 0 {
+		return 0, nil
+	}
+
+	// GetLeaguePlayerStats rewrites stat values in place, so score a copy
+	player.Stats = append([]models.Stat(nil), player.Stats...)
+	_, totalPoints, err := GetLeaguePlayerStats(statModifiers, player)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalPoints / gamesPlayed, nil
+}
+
+// opponentsByDate maps each game date to the opponent of every NHL team playing that day
+func opponentsByDate(games []models.ScheduleGame) map[string]map[string]string {
+	opponents := make(map[string]map[string]string)
+	for _, game := range games {
+		if opponents[game.GameDate] == nil {
+			opponents[game.GameDate] = make(map[string]string)
+		}
+		opponents[game.GameDate][game.HomeTeamAbbrev] = "vs " + game.AwayTeamAbbrev
+		opponents[game.GameDate][game.AwayTeamAbbrev] = "@ " + game.HomeTeamAbbrev
+	}
+	return opponents
+}
+
+// OptimizeLineupForDay fills the starting slots to maximize projected points. Only players whose
+// NHL team plays (a key in opponents) score. Because a player's value does not depend on the slot,
+// adding players best-first and reshuffling earlier starters along augmenting paths is optimal.
+func OptimizeLineupForDay(date string, positions []models.RosterPosition, players []models.RosterPlayer, perGame map[string]float64, opponents map[string]string) models.DailyLineup {
+	lineup := models.DailyLineup{
+		Date:     date,
+		Starters: []models.LineupSlot{},
+		Bench:    []models.BenchDecision{},
+		Start:    []string{},
+		Sit:      []string{},
+	}
+
+	var slots []string
+	for _, position := range positions {
+		if utils.IsBenchPosition(position.Position) {
+			continue
+		}
+		for i := 0; i < position.Count; i++ {
+			slots = append(slots, position.Position)
+		}
+	}
+
+	hasGame := make([]bool, len(players))
+	var candidates []int
+	for i, player := range players {
+		_, hasGame[i] = opponents[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)]
+		if hasGame[i] && !isReserveSlot(player.SelectedPosition) && !utils.IsRuledOut(player.Status) {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return perGame[players[candidates[a]].PlayerKey] > perGame[players[candidates[b]].PlayerKey]
+	})
+
+	owner := make([]int, len(slots))
+	for s := range owner {
+		owner[s] = -1
+	}
+
+	var assign func(p int, visited []bool) bool
+	assign = func(p int, visited []bool) bool {
+		for s, position := range slots {
+			if visited[s] || !canFillSlot(players[p], position) {
+				continue
+			}
+			visited[s] = true
+			if owner[s] == -1 || assign(owner[s], visited) {
+				owner[s] = p
+				return true
+			}
+		}
+		return false
+	}
+
+	starting := make([]bool, len(players))
+	for _, p := range candidates {
+		if assign(p, make([]bool, len(slots))) {
+			starting[p] = true
+		}
+	}
+
+	for s, position := range slots {
+		slot := models.LineupSlot{Position: position}
+		if p := owner[s]; p != -1 {
+			player := players[p]
+			slot.PlayerKey = player.PlayerKey
+			slot.Name = player.Name
+			slot.Opponent = opponents[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)]
+			slot.ProjectedPoints = utils.RoundFloat(perGame[player.PlayerKey], 2)
+			lineup.ProjectedPoints += perGame[player.PlayerKey]
+		}
+		lineup.Starters = append(lineup.Starters, slot)
+	}
+
+	for i, player := range players {
+		currentlyStarting := !utils.IsBenchPosition(player.SelectedPosition)
+		if currentlyStarting && hasGame[i] && !utils.IsRuledOut(player.Status) {
+			lineup.CurrentPoints += perGame[player.PlayerKey]
+		}
+
+		if starting[i] {
+			if !currentlyStarting {
+				lineup.Start = append(lineup.Start, player.Name)
+			}
+			continue
+		}
+		if currentlyStarting && hasGame[i] {
+			lineup.Sit = append(lineup.Sit, player.Name)
+		}
+
+		lineup.Bench = append(lineup.Bench, models.BenchDecision{
+			PlayerKey:         player.PlayerKey,
+			Name:              player.Name,
+			EligiblePositions: player.EligiblePositions,
+			HasGame:           hasGame[i],
+			ProjectedPoints:   utils.RoundFloat(perGame[player.PlayerKey], 2),
+			Reason:            benchReason(player, hasGame[i], date, slots, owner, players, perGame),
+		})
+	}
+
+	lineup.ProjectedPoints = utils.RoundFloat(lineup.ProjectedPoints, 2)
+	lineup.CurrentPoints = utils.RoundFloat(lineup.CurrentPoints, 2)
+	return lineup
+}
+
+func benchReason(player models.RosterPlayer, hasGame bool, date string, slots []string, owner []int, players []models.RosterPlayer, perGame map[string]float64) string {
+	if isReserveSlot(player.SelectedPosition) {
+		return fmt.Sprintf("Held in the %s slot", player.SelectedPosition)
+	}
+	if !hasGame {
+		return fmt.Sprintf("%s has no game on %s", player.TeamAbbreviation, date)
+	}
+	if utils.IsRuledOut(player.Status) {
+		if player.InjuryNote != "" {
+			return fmt.Sprintf("Ruled out (%s: %s)", player.Status, player.InjuryNote)
+		}
+		return fmt.Sprintf("Ruled out (%s)", player.Status)
+	}
+
+	var eligible []string
+	lowest := -1
+	for s, position := range slots {
+		if !canFillSlot(player, position) {
+			continue
+		}
+		eligible = append(eligible, position)
+		if owner[s] != -1 && (lowest == -1 || perGame[players[owner[s]].PlayerKey] < perGame[players[lowest].PlayerKey]) {
+			lowest = owner[s]
+		}
+	}
+	if len(eligible) == 0 || lowest == -1 {
+		return "Not eligible for any starting slot"
+	}
+
+	return fmt.Sprintf("Projects %.2f points; %s (%.2f) is the weakest starter in an eligible slot (%s)",
+		perGame[player.PlayerKey], players[lowest].Name, perGame[players[lowest].PlayerKey], strings.Join(uniquePositions(eligible), ", "))
+}
+
+// canFillSlot reports whether a player may start in a roster slot. Util takes any skater.
+func canFillSlot(player models.RosterPlayer, position string) bool {
+	for _, eligible := range player.EligiblePositions {
+		if eligible == position {
+			return true
+		}
+	}
+	return position == "Util" && player.PositionType == "P"
+}
+
+// isReserveSlot reports whether a player is stashed in an injured or minors slot
+func isReserveSlot(position string) bool {
+	return position == "IR" || position == "IR+" || position == "NA"
+}
+
+func uniquePositions(positions []string) []string {
+	var unique []string
+	seen := make(map[string]bool)
+	for _, position := range positions {
+		if !seen[position] {
+			seen[position] = true
+			unique = append(unique, position)
+		}
+	}
+	return unique
+}
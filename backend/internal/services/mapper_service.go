@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
@@ -118,18 +120,26 @@ func MapToLeague(response map[string]interface{}) (*models.League, error) {
 			}
 		}
 
-		// Extract stat categories to populate statNames
+		// Map stat categories and populate statNames
 		if statCategories, ok := settings["stat_categories"].(map[string]interface{}); ok {
 			if stats, ok := statCategories["stats"].(map[string]interface{}); ok {
-				if statList, ok := stats["stat"].([]interface{}); ok {
-					for _, statData := range statList {
-						if stat, ok := statData.(map[string]interface{}); ok {
-							if statID, ok := stat["stat_id"].(string); ok {
-								if statName, ok := stat["name"].(string); ok {
-									statNames[statID] = statName
-								}
-							}
+				for _, statData := range utils.GetList(stats, "stat") {
+					if stat, ok := statData.(map[string]interface{}); ok {
+						statID := utils.GetString(stat, "stat_id")
+						if statID == "" {
+							continue
+						}
+						if statName, ok := stat["name"].(string); ok {
+							statNames[statID] = statName
 						}
+						league.StatCategories = append(league.StatCategories, models.StatCategory{
+							StatID:            statID,
+							Name:              utils.GetString(stat, "name"),
+							DisplayName:       utils.GetString(stat, "display_name"),
+							PositionType:      utils.GetString(stat, "position_type"),
+							LowerIsBetter:     utils.GetString(stat, "sort_order") == "0",
+							IsOnlyDisplayStat: utils.GetString(stat, "is_only_display_stat") == "1",
+						})
 					}
 				}
 			}
@@ -280,6 +290,9 @@ func MapPlayer(response map[string]interface{}) (*models.Player, error) {
 		PlayerNotes:        utils.GetBool(playerData, "has_player_notes"),
 		RecentNotes:        utils.GetBool(playerData, "has_recent_player_notes"),
 		PlayerNotesUpdated: utils.GetInt(playerData, "player_notes_last_timestamp"),
+		Status:             utils.GetString(playerData, "status"),
+		StatusFull:         utils.GetString(playerData, "status_full"),
+		InjuryNote:         utils.GetString(playerData, "injury_note"),
 		Stats:              statsData,
 		AdvancedStats:      advancedStatsData,
 	}
@@ -337,8 +350,14 @@ func extractStats(data map[string]interface{}, key string) []models.Stat {
 
 func extractPositions(positionsData interface{}) []string {
 	positions := []string{}
-	positionsArray, ok := positionsData.([]interface{})
-	if !ok {
+
+	var positionsArray []interface{}
+	switch v := positionsData.(type) {
+	case []interface{}:
+		positionsArray = v
+	case map[string]interface{}: // <eligible_positions><position>C</position>...
+		positionsArray = utils.GetList(v, "position")
+	default:
 		return positions
 	}
 
@@ -388,6 +407,46 @@ func MapToRank(data map[string]interface{}) ([]models.PlayerRank, error) {
 	return playerRanks, nil
 }
 
+// MapPlayerRanksByKey maps a league/{leagueKey}/players;out=ranks response into each player's ranks
+func MapPlayerRanksByKey(data map[string]interface{}) (map[string][]models.PlayerRank, error) {
+	leagueData, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid league data")
+	}
+
+	ranks := make(map[string][]models.PlayerRank)
+	playersData, ok := leagueData["players"].(map[string]interface{})
+	if !ok {
+		return ranks, nil
+	}
+
+	for _, entry := range utils.GetList(playersData, "player") {
+		playerMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		playerKey := utils.GetString(playerMap, "player_key")
+		playerRanks := []models.PlayerRank{}
+		if ranksData, ok := playerMap["player_ranks"].(map[string]interface{}); ok {
+			for _, rankData := range utils.GetList(ranksData, "player_rank") {
+				rankMap, ok := rankData.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				playerRanks = append(playerRanks, models.PlayerRank{
+					RankType:   utils.GetString(rankMap, "rank_type"),
+					RankValue:  utils.GetInt(rankMap, "rank_value"),
+					RankSeason: utils.GetString(rankMap, "rank_season"),
+				})
+			}
+		}
+		ranks[playerKey] = playerRanks
+	}
+
+	return ranks, nil
+}
+
 func mapNHLPlayer(data interface{}) (*models.NHLPlayer, error) {
 	playerMap, ok := data.(map[string]interface{})
 	if !ok {
@@ -487,6 +546,9 @@ func MapToYahooPlayer(data map[string]interface{}) ([]models.YahooPlayer, error)
 			FullName:    fullName,
 			TeamName:    teamName,
 			HeadshotURL: headshotURL,
+			Status:      utils.GetString(playerMap, "status"),
+			StatusFull:  utils.GetString(playerMap, "status_full"),
+			InjuryNote:  utils.GetString(playerMap, "injury_note"),
 		})
 	}
 
@@ -637,6 +699,33 @@ func MapNHLGameStat(data map[string]interface{}) (*models.PlayerGameStat, error)
 		playerGameStat.TOI = toi
 	}
 
+	// Goalie game logs replace the skater scoring fields with these
+	if gamesStarted, exists := data["gamesStarted"].(float64); exists {
+		playerGameStat.GamesStarted = int(gamesStarted)
+	}
+
+	if decision, exists := data["decision"].(string); exists {
+		playerGameStat.Decision = decision
+	}
+
+	if shotsAgainst, exists := data["shotsAgainst"].(float64); exists {
+		playerGameStat.ShotsAgainst = int(shotsAgainst)
+	}
+
+	if goalsAgainst, exists := data["goalsAgainst"].(float64); exists {
+		playerGameStat.GoalsAgainst = int(goalsAgainst)
+	}
+
+	if savePctg, exists := data["savePctg"].(float64); exists {
+		playerGameStat.SavePctg = savePctg
+	}
+
+	if shutouts, exists := data["shutouts"].(float64); exists {
+		playerGameStat.Shutouts = int(shutouts)
+	}
+
+	playerGameStat.Saves = playerGameStat.ShotsAgainst - playerGameStat.GoalsAgainst
+
 	// Extract `team` from `commonName.default`
 	if commonName, exists := data["commonName"].(map[string]interface{}); exists {
 		if team, ok := commonName["default"].(string); ok {
@@ -669,6 +758,32 @@ func MapTeamMatchups(data map[string]interface{}) ([]*models.Matchup, []*models.
 		return nil, nil, nil, errors.New("invalid matchup list format")
 	}
 
+	matchups, teamStats, statWinners := mapDecidedMatchups(matchupList)
+	return matchups, teamStats, statWinners, nil
+}
+
+// MapLeagueScoreboard maps the decided matchups of a league/{leagueKey}/scoreboard;week={week} response
+// with their team stats and stat winners, the same way MapTeamMatchups does for a single team
+func MapLeagueScoreboard(data map[string]interface{}) ([]*models.Matchup, []*models.TeamWeeklyStats, []*models.StatWinnerWeeklyMatchup, error) {
+	leagueData, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, nil, nil, errors.New("invalid league data")
+	}
+	scoreboardData, ok := leagueData["scoreboard"].(map[string]interface{})
+	if !ok {
+		return nil, nil, nil, errors.New("missing 'scoreboard' in league data")
+	}
+	matchupsData, ok := scoreboardData["matchups"].(map[string]interface{})
+	if !ok {
+		return nil, nil, nil, nil
+	}
+
+	matchups, teamStats, statWinners := mapDecidedMatchups(utils.GetList(matchupsData, "matchup"))
+	return matchups, teamStats, statWinners, nil
+}
+
+// mapDecidedMatchups maps Yahoo matchup entries, skipping those still in progress
+func mapDecidedMatchups(matchupList []interface{}) ([]*models.Matchup, []*models.TeamWeeklyStats, []*models.StatWinnerWeeklyMatchup) {
 	var matchups []*models.Matchup
 	var teamStats []*models.TeamWeeklyStats
 	var statWinners []*models.StatWinnerWeeklyMatchup
@@ -679,9 +794,11 @@ func MapTeamMatchups(data map[string]interface{}) ([]*models.Matchup, []*models.
 			continue
 		}
 
+		// Matchups still in progress have neither a winner nor a tie
 		week := utils.GetString(matchupMap, "week")
 		winnerTeam := utils.GetString(matchupMap, "winner_team_key")
-		if winnerTeam == "" {
+		isTied := utils.GetBool(matchupMap, "is_tied")
+		if winnerTeam == "" && !isTied {
 			continue
 		}
 
@@ -690,10 +807,7 @@ func MapTeamMatchups(data map[string]interface{}) ([]*models.Matchup, []*models.
 			continue
 		}
 
-		teams, ok := teamsData["team"].([]interface{})
-		if !ok {
-			continue
-		}
+		teams := utils.GetList(teamsData, "team")
 
 		var teamKeys []string
 		for _, team := range teams {
@@ -708,6 +822,13 @@ func MapTeamMatchups(data map[string]interface{}) ([]*models.Matchup, []*models.
 			teamStats = append(teamStats, teamStatsData)
 		}
 
+		if len(teamKeys) < 2 {
+			continue
+		}
+
+		if isTied {
+			winnerTeam = teamKeys[0]
+		}
 		losingTeam := teamKeys[0]
 		if teamKeys[0] == winnerTeam {
 			losingTeam = teamKeys[1]
@@ -719,6 +840,7 @@ func MapTeamMatchups(data map[string]interface{}) ([]*models.Matchup, []*models.
 			Week:        week,
 			WinningTeam: winnerTeam,
 			LosingTeam:  losingTeam,
+			IsTied:      isTied,
 		})
 
 		if statWinnersData, ok := matchupMap["stat_winners"].(map[string]interface{}); ok {
@@ -727,7 +849,7 @@ func MapTeamMatchups(data map[string]interface{}) ([]*models.Matchup, []*models.
 		}
 	}
 
-	return matchups, teamStats, statWinners, nil
+	return matchups, teamStats, statWinners
 }
 
 func extractTeamStats(teamMap map[string]interface{}, teamKey, week string) *models.TeamWeeklyStats {
@@ -800,3 +922,812 @@ func extractStatWinners(statWinnersData map[string]interface{}, week, matchupId
 	}
 	return statWinners
 }
+
+func MapLeagueStandings(data map[string]interface{}) ([]models.TeamStanding, error) {
+	league, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("missing 'league' data")
+	}
+
+	leagueKey := utils.GetString(league, "league_key")
+	week := utils.GetInt(league, "current_week")
+
+	standingsData, ok := league["standings"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("missing 'standings' in league data")
+	}
+
+	teamsData, ok := standingsData["teams"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("missing 'teams' in standings data")
+	}
+
+	teamList := utils.GetList(teamsData, "team")
+	if len(teamList) == 0 {
+		return nil, errors.New("missing 'team' list in standings data")
+	}
+
+	var standings []models.TeamStanding
+	for _, teamEntry := range teamList {
+		teamMap, ok := teamEntry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		standing := models.TeamStanding{
+			LeagueID: leagueKey,
+			TeamKey:  utils.GetString(teamMap, "team_key"),
+			Week:     week,
+			Name:     utils.GetString(teamMap, "name"),
+		}
+
+		if teamStandings, ok := teamMap["team_standings"].(map[string]interface{}); ok {
+			standing.Rank = utils.GetInt(teamStandings, "rank")
+			standing.PlayoffSeed = utils.GetInt(teamStandings, "playoff_seed")
+			standing.PointsFor = utils.GetFloat(teamStandings, "points_for")
+			standing.PointsAgainst = utils.GetFloat(teamStandings, "points_against")
+
+			if outcomes, ok := teamStandings["outcome_totals"].(map[string]interface{}); ok {
+				standing.Wins = utils.GetInt(outcomes, "wins")
+				standing.Losses = utils.GetInt(outcomes, "losses")
+				standing.Ties = utils.GetInt(outcomes, "ties")
+				standing.Percentage = utils.GetFloat(outcomes, "percentage")
+			}
+
+			if streak, ok := teamStandings["streak"].(map[string]interface{}); ok {
+				standing.StreakType = utils.GetString(streak, "type")
+				standing.StreakValue = utils.GetInt(streak, "value")
+			}
+		}
+
+		standings = append(standings, standing)
+	}
+
+	return standings, nil
+}
+
+func MapTeamRoster(data map[string]interface{}) (*models.TeamRoster, error) {
+	teamData, ok := data["team"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid team data")
+	}
+
+	rosterData, ok := teamData["roster"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("missing 'roster' in team data")
+	}
+
+	roster := &models.TeamRoster{
+		TeamKey:      utils.GetString(teamData, "team_key"),
+		CoverageType: utils.GetString(rosterData, "coverage_type"),
+		Players:      []models.RosterPlayer{},
+	}
+	if roster.CoverageType == "date" {
+		roster.Coverage = utils.GetString(rosterData, "date")
+	} else {
+		roster.Coverage = utils.GetString(rosterData, "week")
+	}
+
+	playersData, ok := rosterData["players"].(map[string]interface{})
+	if !ok {
+		// Empty rosters have no players element
+		return roster, nil
+	}
+
+	for _, playerEntry := range utils.GetList(playersData, "player") {
+		playerMap, ok := playerEntry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		player := models.RosterPlayer{
+			PlayerKey:         utils.GetString(playerMap, "player_key"),
+			PlayerID:          utils.GetString(playerMap, "player_id"),
+			TeamAbbreviation:  utils.GetString(playerMap, "editorial_team_abbr"),
+			DisplayPosition:   utils.GetString(playerMap, "display_position"),
+			PositionType:      utils.GetString(playerMap, "position_type"),
+			EligiblePositions: extractPositions(playerMap["eligible_positions"]),
+			Status:            utils.GetString(playerMap, "status"),
+			StatusFull:        utils.GetString(playerMap, "status_full"),
+			InjuryNote:        utils.GetString(playerMap, "injury_note"),
+		}
+
+		if nameData, ok := playerMap["name"].(map[string]interface{}); ok {
+			player.Name = utils.GetString(nameData, "full")
+		}
+
+		if selected, ok := playerMap["selected_position"].(map[string]interface{}); ok {
+			player.SelectedPosition = utils.GetString(selected, "position")
+		}
+		player.IsStarting = !utils.IsBenchPosition(player.SelectedPosition)
+
+		if points, ok := playerMap["player_points"].(map[string]interface{}); ok {
+			player.Points = utils.GetFloat(points, "total")
+		}
+
+		if _, ok := playerMap["player_stats"]; ok {
+			player.Stats = extractStats(playerMap, "player_stats")
+		}
+
+		roster.Players = append(roster.Players, player)
+	}
+
+	return roster, nil
+}
+
+// MapLeaguePlayers maps the players collection of a league, such as a free agent search
+func MapLeaguePlayers(data map[string]interface{}) ([]models.Player, error) {
+	leagueData, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid league data")
+	}
+
+	players := []models.Player{}
+	playersData, ok := leagueData["players"].(map[string]interface{})
+	if !ok {
+		// No players matched the search
+		return players, nil
+	}
+
+	for _, playerEntry := range utils.GetList(playersData, "player") {
+		playerMap, ok := playerEntry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		player := models.Player{
+			PlayerID:          utils.GetString(playerMap, "player_id"),
+			PlayerKey:         utils.GetString(playerMap, "player_key"),
+			TeamFullName:      utils.GetString(playerMap, "editorial_team_full_name"),
+			TeamAbbreviation:  utils.GetString(playerMap, "editorial_team_abbr"),
+			DisplayPosition:   utils.GetString(playerMap, "display_position"),
+			PositionType:      utils.GetString(playerMap, "position_type"),
+			EligiblePositions: extractPositions(playerMap["eligible_positions"]),
+			Status:            utils.GetString(playerMap, "status"),
+			StatusFull:        utils.GetString(playerMap, "status_full"),
+			InjuryNote:        utils.GetString(playerMap, "injury_note"),
+			Stats:             []models.Stat{},
+		}
+
+		if nameData, ok := playerMap["name"].(map[string]interface{}); ok {
+			player.Name = models.PlayerName{
+				FullName:   utils.GetString(nameData, "full"),
+				FirstName:  utils.GetString(nameData, "first"),
+				LastName:   utils.GetString(nameData, "last"),
+				AsciiFirst: utils.GetString(nameData, "ascii_first"),
+				AsciiLast:  utils.GetString(nameData, "ascii_last"),
+			}
+		}
+
+		if headshot, ok := playerMap["headshot"].(map[string]interface{}); ok {
+			player.HeadshotURL = utils.GetString(headshot, "url")
+		}
+
+		if _, ok := playerMap["player_stats"]; ok {
+			player.Stats = extractStats(playerMap, "player_stats")
+		}
+
+		players = append(players, player)
+	}
+
+	return players, nil
+}
+
+// MapScheduleGame maps a game from the NHL club schedule, including its state and final score
+func MapScheduleGame(data map[string]interface{}) (*models.ScheduleGame, error) {
+	id, ok := data["id"].(float64)
+	if !ok {
+		return nil, errors.New("schedule game has no id")
+	}
+
+	startTime, err := time.Parse(time.RFC3339, utils.GetString(data, "startTimeUTC"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse start time for game ID %.0f: %w", id, err)
+	}
+
+	game := &models.ScheduleGame{
+		ID:            int64(id),
+		Season:        utils.GetInt(data, "season"),
+		GameType:      utils.GetInt(data, "gameType"),
+		GameDate:      utils.GetString(data, "gameDate"),
+		StartTimeUTC:  startTime,
+		GameState:     utils.GetString(data, "gameState"),
+		ScheduleState: utils.GetString(data, "gameScheduleState"),
+	}
+
+	if homeTeam, ok := data["homeTeam"].(map[string]interface{}); ok {
+		game.HomeTeamAbbrev = utils.GetString(homeTeam, "abbrev")
+		game.HomeScore = utils.GetInt(homeTeam, "score")
+	}
+	if awayTeam, ok := data["awayTeam"].(map[string]interface{}); ok {
+		game.AwayTeamAbbrev = utils.GetString(awayTeam, "abbrev")
+		game.AwayScore = utils.GetInt(awayTeam, "score")
+	}
+	if outcome, ok := data["gameOutcome"].(map[string]interface{}); ok {
+		game.LastPeriodType = utils.GetString(outcome, "lastPeriodType")
+	}
+
+	return game, nil
+}
+
+// MapScoreboardGame maps a game from the NHL daily scoreboard
+func MapScoreboardGame(data map[string]interface{}) (*models.LiveGame, error) {
+	id, ok := data["id"].(float64)
+	if !ok {
+		return nil, errors.New("scoreboard game has no id")
+	}
+
+	game := &models.LiveGame{
+		ID:        int64(id),
+		GameDate:  utils.GetString(data, "gameDate"),
+		GameState: utils.GetString(data, "gameState"),
+		Period:    utils.GetInt(data, "period"),
+	}
+	game.Status = GameStatus(game.GameState, utils.GetString(data, "gameScheduleState"))
+
+	if startTime, err := time.Parse(time.RFC3339, utils.GetString(data, "startTimeUTC")); err == nil {
+		game.StartTimeUTC = startTime
+	}
+
+	if homeTeam, ok := data["homeTeam"].(map[string]interface{}); ok {
+		game.HomeTeamAbbrev = utils.GetString(homeTeam, "abbrev")
+		game.HomeScore = utils.GetInt(homeTeam, "score")
+		game.HomeShots = utils.GetInt(homeTeam, "sog")
+	}
+	if awayTeam, ok := data["awayTeam"].(map[string]interface{}); ok {
+		game.AwayTeamAbbrev = utils.GetString(awayTeam, "abbrev")
+		game.AwayScore = utils.GetInt(awayTeam, "score")
+		game.AwayShots = utils.GetInt(awayTeam, "sog")
+	}
+	if descriptor, ok := data["periodDescriptor"].(map[string]interface{}); ok {
+		game.PeriodType = utils.GetString(descriptor, "periodType")
+		if game.Period == 0 {
+			game.Period = utils.GetInt(descriptor, "number")
+		}
+	}
+	if clock, ok := data["clock"].(map[string]interface{}); ok {
+		game.Clock = utils.GetString(clock, "timeRemaining")
+		game.InIntermission, _ = clock["inIntermission"].(bool)
+	}
+
+	return game, nil
+}
+
+// MapBoxscorePlayers maps every skater and goalie line in an NHL boxscore, keyed by NHL player id
+func MapBoxscorePlayers(data map[string]interface{}) map[string]*models.PlayerGameStat {
+	lines := make(map[string]*models.PlayerGameStat)
+
+	byTeam, ok := data["playerByGameStats"].(map[string]interface{})
+	if !ok {
+		return lines
+	}
+
+	for _, side := range []string{"homeTeam", "awayTeam"} {
+		team, ok := byTeam[side].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		teamAbbrev := ""
+		if teamData, ok := data[side].(map[string]interface{}); ok {
+			teamAbbrev = utils.GetString(teamData, "abbrev")
+		}
+
+		for _, group := range []string{"forwards", "defense", "goalies"} {
+			players, _ := team[group].([]interface{})
+			for _, entry := range players {
+				playerMap, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				line := &models.PlayerGameStat{
+					PlayerID:       strconv.Itoa(utils.GetInt(playerMap, "playerId")),
+					GameDate:       utils.GetString(data, "gameDate"),
+					TeamAbbrev:     teamAbbrev,
+					Goals:          utils.GetInt(playerMap, "goals"),
+					Assists:        utils.GetInt(playerMap, "assists"),
+					Points:         utils.GetInt(playerMap, "points"),
+					PlusMinus:      utils.GetInt(playerMap, "plusMinus"),
+					PIM:            utils.GetInt(playerMap, "pim"),
+					PowerPlayGoals: utils.GetInt(playerMap, "powerPlayGoals"),
+					Shots:          utils.GetInt(playerMap, "sog"),
+					Shifts:         utils.GetInt(playerMap, "shifts"),
+					TOI:            utils.GetString(playerMap, "toi"),
+				}
+				// Boxscores only break out power-play goals, so power-play assists wait for the game log
+				line.PowerPlayPoints = line.PowerPlayGoals
+				if gameID, ok := data["id"].(float64); ok {
+					line.GameID = fmt.Sprintf("%.0f", gameID)
+				}
+
+				if group == "goalies" {
+					mapBoxscoreGoalie(line, playerMap)
+				}
+
+				lines[line.PlayerID] = line
+			}
+		}
+	}
+
+	return lines
+}
+
+// mapBoxscoreGoalie reads a goalie line, where shots come as "saves/shots against"
+func mapBoxscoreGoalie(line *models.PlayerGameStat, data map[string]interface{}) {
+	if starter, ok := data["starter"].(bool); ok && starter {
+		line.GamesStarted = 1
+	}
+	line.Decision = utils.GetString(data, "decision")
+	line.GoalsAgainst = utils.GetInt(data, "goalsAgainst")
+	if savePctg, ok := data["savePctg"].(float64); ok {
+		line.SavePctg = savePctg
+	}
+
+	parts := strings.Split(utils.GetString(data, "saveShotsAgainst"), "/")
+	if len(parts) == 2 {
+		line.Saves, _ = strconv.Atoi(parts[0])
+		line.ShotsAgainst, _ = strconv.Atoi(parts[1])
+	}
+
+	// A decision is only given once the game is over
+	if line.Decision == "W" && line.GoalsAgainst == 0 {
+		line.Shutouts = 1
+	}
+}
+
+// MapLeagueTransactions maps a league's transactions collection with the players each one moved
+func MapLeagueTransactions(data map[string]interface{}) ([]models.Transaction, error) {
+	leagueData, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid league data")
+	}
+	leagueKey := utils.GetString(leagueData, "league_key")
+
+	transactions := []models.Transaction{}
+	transactionsData, ok := leagueData["transactions"].(map[string]interface{})
+	if !ok {
+		// No transactions yet this season
+		return transactions, nil
+	}
+
+	for _, entry := range utils.GetList(transactionsData, "transaction") {
+		transactionMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		transaction := models.Transaction{
+			TransactionKey: utils.GetString(transactionMap, "transaction_key"),
+			LeagueKey:      leagueKey,
+			Type:           utils.GetString(transactionMap, "type"),
+			Status:         utils.GetString(transactionMap, "status"),
+			Timestamp:      time.Unix(utils.GetInt64(transactionMap, "timestamp"), 0),
+			FaabBid:        utils.GetInt(transactionMap, "faab_bid"),
+			TraderTeamKey:  utils.GetString(transactionMap, "trader_team_key"),
+			TradeeTeamKey:  utils.GetString(transactionMap, "tradee_team_key"),
+			Players:        []models.TransactionPlayer{},
+		}
+
+		playersData, _ := transactionMap["players"].(map[string]interface{})
+		for _, playerEntry := range utils.GetList(playersData, "player") {
+			playerMap, ok := playerEntry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			player := models.TransactionPlayer{
+				TransactionKey:   transaction.TransactionKey,
+				PlayerKey:        utils.GetString(playerMap, "player_key"),
+				TeamAbbreviation: utils.GetString(playerMap, "editorial_team_abbr"),
+				DisplayPosition:  utils.GetString(playerMap, "display_position"),
+			}
+			if nameData, ok := playerMap["name"].(map[string]interface{}); ok {
+				player.PlayerName = utils.GetString(nameData, "full")
+			}
+
+			moves := utils.GetList(playerMap, "transaction_data")
+			if len(moves) > 0 {
+				if move, ok := moves[0].(map[string]interface{}); ok {
+					player.Type = utils.GetString(move, "type")
+					player.SourceType = utils.GetString(move, "source_type")
+					player.SourceTeamKey = utils.GetString(move, "source_team_key")
+					player.DestinationType = utils.GetString(move, "destination_type")
+					player.DestinationTeamKey = utils.GetString(move, "destination_team_key")
+				}
+			}
+
+			transaction.Players = append(transaction.Players, player)
+		}
+
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+// MapDraftResults maps a league's draft results in pick order
+func MapDraftResults(data map[string]interface{}) ([]models.DraftPick, error) {
+	leagueData, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid league data")
+	}
+	leagueKey := utils.GetString(leagueData, "league_key")
+
+	picks := []models.DraftPick{}
+	resultsData, ok := leagueData["draft_results"].(map[string]interface{})
+	if !ok {
+		// The draft has not started
+		return picks, nil
+	}
+
+	for _, entry := range utils.GetList(resultsData, "draft_result") {
+		resultMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		pick := models.DraftPick{
+			LeagueKey: leagueKey,
+			Pick:      utils.GetInt(resultMap, "pick"),
+			Round:     utils.GetInt(resultMap, "round"),
+			TeamKey:   utils.GetString(resultMap, "team_key"),
+			PlayerKey: utils.GetString(resultMap, "player_key"),
+			Cost:      utils.GetInt(resultMap, "cost"),
+		}
+		// Picks still to be made in a live draft have no player
+		if pick.PlayerKey == "" {
+			continue
+		}
+		picks = append(picks, pick)
+	}
+
+	return picks, nil
+}
+
+// MapUserLeagues maps the leagues of every game in a users;use_login=1/games/leagues response
+func MapUserLeagues(data map[string]interface{}) ([]models.UserLeague, error) {
+	games, err := userGames(data)
+	if err != nil {
+		return nil, err
+	}
+
+	leagues := []models.UserLeague{}
+	for _, game := range games {
+		leaguesData, ok := game["leagues"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, entry := range utils.GetList(leaguesData, "league") {
+			leagueMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			leagues = append(leagues, models.UserLeague{
+				LeagueKey:   utils.GetString(leagueMap, "league_key"),
+				Name:        utils.GetString(leagueMap, "name"),
+				GameCode:    utils.GetString(leagueMap, "game_code"),
+				Season:      utils.GetString(leagueMap, "season"),
+				NumTeams:    utils.GetInt(leagueMap, "num_teams"),
+				ScoringType: utils.GetString(leagueMap, "scoring_type"),
+				DraftStatus: utils.GetString(leagueMap, "draft_status"),
+				CurrentWeek: utils.GetInt(leagueMap, "current_week"),
+				StartDate:   utils.GetString(leagueMap, "start_date"),
+				EndDate:     utils.GetString(leagueMap, "end_date"),
+				IsFinished:  utils.GetBool(leagueMap, "is_finished"),
+			})
+		}
+	}
+
+	return leagues, nil
+}
+
+// MapUserTeams maps the logged in user's teams in a users;use_login=1/games/teams response
+func MapUserTeams(data map[string]interface{}) ([]models.LeagueTeam, error) {
+	games, err := userGames(data)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := []models.LeagueTeam{}
+	for _, game := range games {
+		teamsData, ok := game["teams"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, entry := range utils.GetList(teamsData, "team") {
+			teamMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			teamKey := utils.GetString(teamMap, "team_key")
+			leagueKey, err := utils.TeamtoLeagueId(teamKey)
+			if err != nil {
+				continue
+			}
+			teams = append(teams, models.LeagueTeam{
+				TeamId:   teamKey,
+				LeagueId: leagueKey,
+				Name:     utils.GetString(teamMap, "name"),
+			})
+		}
+	}
+
+	return teams, nil
+}
+
+func userGames(data map[string]interface{}) ([]map[string]interface{}, error) {
+	usersData, ok := data["users"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid users data")
+	}
+	userData, ok := usersData["user"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid user data")
+	}
+
+	var games []map[string]interface{}
+	gamesData, ok := userData["games"].(map[string]interface{})
+	if !ok {
+		return games, nil
+	}
+	for _, entry := range utils.GetList(gamesData, "game") {
+		if gameMap, ok := entry.(map[string]interface{}); ok {
+			games = append(games, gameMap)
+		}
+	}
+	return games, nil
+}
+
+// MapGameWeeks maps a game/{gameKey}/game_weeks response
+func MapGameWeeks(data map[string]interface{}) ([]models.GameWeek, error) {
+	gameData, ok := data["game"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid game data")
+	}
+	weeksData, ok := gameData["game_weeks"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid game weeks data")
+	}
+
+	var weeks []models.GameWeek
+	for _, entry := range utils.GetList(weeksData, "game_week") {
+		weekMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		start, err := time.Parse(lineupDateLayout, utils.GetString(weekMap, "start"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of week %s: %w", utils.GetString(weekMap, "week"), err)
+		}
+		end, err := time.Parse(lineupDateLayout, utils.GetString(weekMap, "end"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid end of week %s: %w", utils.GetString(weekMap, "week"), err)
+		}
+
+		weeks = append(weeks, models.GameWeek{
+			Week:  utils.GetInt(weekMap, "week"),
+			Start: start,
+			End:   end,
+		})
+	}
+
+	return weeks, nil
+}
+
+// MapTeamMatchup maps the matchup in a team/{teamKey}/matchups;weeks={week} response from the
+// team's side. It returns nil when the team has no matchup that week.
+func MapTeamMatchup(data map[string]interface{}, teamKey string) (*models.MatchupScore, error) {
+	teamData, ok := data["team"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid team data")
+	}
+	matchupsData, ok := teamData["matchups"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	matchups := utils.GetList(matchupsData, "matchup")
+	if len(matchups) == 0 {
+		return nil, nil
+	}
+	matchupMap, ok := matchups[0].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid matchup data")
+	}
+
+	matchup := &models.MatchupScore{
+		Week:      utils.GetInt(matchupMap, "week"),
+		Status:    utils.GetString(matchupMap, "status"),
+		WeekStart: utils.GetString(matchupMap, "week_start"),
+		WeekEnd:   utils.GetString(matchupMap, "week_end"),
+	}
+
+	teamsData, ok := matchupMap["teams"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid matchup teams")
+	}
+	for _, entry := range utils.GetList(teamsData, "team") {
+		sideMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		side := models.MatchupTeam{
+			TeamKey: utils.GetString(sideMap, "team_key"),
+			Name:    utils.GetString(sideMap, "name"),
+			Stats:   extractTeamStats(sideMap, "", "").Stats,
+		}
+		if pointsData, ok := sideMap["team_points"].(map[string]interface{}); ok {
+			side.Points = utils.GetFloat(pointsData, "total")
+		}
+		if projectedData, ok := sideMap["team_projected_points"].(map[string]interface{}); ok {
+			side.ProjectedPoints = utils.GetFloat(projectedData, "total")
+		}
+
+		if side.TeamKey == teamKey {
+			matchup.Team = side
+		} else {
+			matchup.Opponent = side
+		}
+	}
+
+	return matchup, nil
+}
+
+// MapLeagueSeason maps a league/{leagueKey}/settings response into an archived season
+func MapLeagueSeason(data map[string]interface{}) (*models.LeagueSeason, error) {
+	league, err := MapToLeague(data)
+	if err != nil {
+		return nil, err
+	}
+	leagueData := data["league"].(map[string]interface{})
+
+	return &models.LeagueSeason{
+		LeagueKey:         league.LeagueKey,
+		Season:            league.Season,
+		Name:              league.Name,
+		NumTeams:          league.NumTeams,
+		ScoringType:       league.ScoringType,
+		StartWeek:         league.StartWeek,
+		EndWeek:           league.EndWeek,
+		CurrentWeek:       league.CurrentWeek,
+		PreviousLeagueKey: renewedLeagueKey(utils.GetString(leagueData, "renew")),
+		NextLeagueKey:     renewedLeagueKey(utils.GetString(leagueData, "renewed")),
+		IsFinished:        utils.GetBool(leagueData, "is_finished"),
+		RosterPositions:   league.RosterPositions,
+		StatModifiers:     league.StatModifiers,
+	}, nil
+}
+
+// MapSeasonTeams maps a league/{leagueKey}/standings response into each team's final standing
+// and manager
+func MapSeasonTeams(data map[string]interface{}, season string) ([]models.SeasonTeam, error) {
+	standings, err := MapLeagueStandings(data)
+	if err != nil {
+		return nil, err
+	}
+
+	managers := make(map[string]map[string]interface{})
+	standingsData := data["league"].(map[string]interface{})["standings"].(map[string]interface{})
+	for _, entry := range utils.GetList(standingsData["teams"].(map[string]interface{}), "team") {
+		teamMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		managersData, ok := teamMap["managers"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// Co-managed teams list the primary manager first
+		if list := utils.GetList(managersData, "manager"); len(list) > 0 {
+			if manager, ok := list[0].(map[string]interface{}); ok {
+				managers[utils.GetString(teamMap, "team_key")] = manager
+			}
+		}
+	}
+
+	teams := make([]models.SeasonTeam, 0, len(standings))
+	for _, standing := range standings {
+		team := models.SeasonTeam{
+			LeagueKey:     standing.LeagueID,
+			TeamKey:       standing.TeamKey,
+			Season:        season,
+			Name:          standing.Name,
+			Rank:          standing.Rank,
+			PlayoffSeed:   standing.PlayoffSeed,
+			Wins:          standing.Wins,
+			Losses:        standing.Losses,
+			Ties:          standing.Ties,
+			PointsFor:     standing.PointsFor,
+			PointsAgainst: standing.PointsAgainst,
+		}
+		if manager, ok := managers[standing.TeamKey]; ok {
+			team.ManagerID = utils.GetString(manager, "guid")
+			team.ManagerName = utils.GetString(manager, "nickname")
+		}
+		// Managers who hide their profile have no guid, so fall back to the nickname
+		if team.ManagerID == "" {
+			team.ManagerID = team.ManagerName
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+// MapScoreboardMatchups maps the decided matchups of a league/{leagueKey}/scoreboard;week={week} response
+func MapScoreboardMatchups(data map[string]interface{}) ([]models.SeasonMatchup, error) {
+	leagueData, ok := data["league"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid league data")
+	}
+	leagueKey := utils.GetString(leagueData, "league_key")
+
+	matchups := []models.SeasonMatchup{}
+	scoreboardData, ok := leagueData["scoreboard"].(map[string]interface{})
+	if !ok {
+		return matchups, nil
+	}
+	matchupsData, ok := scoreboardData["matchups"].(map[string]interface{})
+	if !ok {
+		return matchups, nil
+	}
+
+	for _, entry := range utils.GetList(matchupsData, "matchup") {
+		matchupMap, ok := entry.(map[string]interface{})
+		if !ok || utils.GetString(matchupMap, "status") != "postevent" {
+			continue
+		}
+		teamsData, ok := matchupMap["teams"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		teams := utils.GetList(teamsData, "team")
+		if len(teams) != 2 {
+			continue
+		}
+
+		var keys [2]string
+		var points [2]float64
+		for i, team := range teams {
+			teamMap, ok := team.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			keys[i] = utils.GetString(teamMap, "team_key")
+			if pointsData, ok := teamMap["team_points"].(map[string]interface{}); ok {
+				points[i] = utils.GetFloat(pointsData, "total")
+			}
+		}
+
+		matchups = append(matchups, models.SeasonMatchup{
+			LeagueKey:      leagueKey,
+			Week:           utils.GetInt(matchupMap, "week"),
+			TeamKey:        keys[0],
+			OpponentKey:    keys[1],
+			TeamPoints:     points[0],
+			OpponentPoints: points[1],
+			WinnerTeamKey:  utils.GetString(matchupMap, "winner_team_key"),
+			IsTied:         utils.GetBool(matchupMap, "is_tied"),
+			IsPlayoffs:     utils.GetBool(matchupMap, "is_playoffs"),
+			IsConsolation:  utils.GetBool(matchupMap, "is_consolation"),
+		})
+	}
+
+	return matchups, nil
+}
+
+// renewedLeagueKey turns a renew value such as "453_29317" into the league key "453.l.29317"
+func renewedLeagueKey(renew string) string {
+	parts := strings.SplitN(renew, "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+	return parts[0] + ".l." + parts[1]
+}
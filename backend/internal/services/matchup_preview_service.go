@@ -0,0 +1,352 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	DefaultPreviewSimulations = 10000
+	MaxPreviewSimulations     = 100000
+)
+
+// SideProjection is one team's position in a matchup: what it has scored so far and what its
+// optimal lineups are projected to add over the games left
+type SideProjection struct {
+	ActualPoints    float64
+	RemainingPoints float64
+	PointsVariance  float64
+	ActualStats     map[string]float64
+	RemainingStats  map[string]float64
+	RemainingStarts int
+	StartsPerPlayer map[string]int
+}
+
+// MatchupSimulation is the share of simulated weeks each result came up in
+type MatchupSimulation struct {
+	Win            float64
+	Tie            float64
+	Loss           float64
+	CategoryWins   map[string]float64 // Ties count half
+	ExpectedWins   float64
+	ExpectedLosses float64
+}
+
+// GetMatchupPreview projects a team's matchup for the current week, or for "next", or a week
+// number. Points or categories already scored are kept and only games that have not started are
+// projected, so the preview sharpens as the week goes on.
+func GetMatchupPreview(sessionId, teamId, week string, simulations int) (*models.MatchupPreview, error) {
+	if simulations <= 0 {
+		simulations = DefaultPreviewSimulations
+	}
+
+	leagueId, err := utils.TeamtoLeagueId(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if week == "next" {
+		week = strconv.Itoa(settings.CurrentWeek + 1)
+	}
+	weekNumber, start, end, err := leagueWeekDates(sessionId, settings, week)
+	if err != nil {
+		return nil, err
+	}
+
+	matchup, err := GetTeamMatchup(sessionId, teamId, weekNumber)
+	if err != nil {
+		return nil, err
+	}
+	if matchup == nil {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("team %s has no matchup in week %d", teamId, weekNumber))
+	}
+
+	teamRoster, err := GetFantasyTeamRoster(sessionId, teamId, "", "")
+	if err != nil {
+		return nil, err
+	}
+	opponentRoster, err := GetFantasyTeamRoster(sessionId, matchup.Opponent.TeamKey, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from := start
+	today, _ := time.Parse(lineupDateLayout, now.Format(lineupDateLayout))
+	if today.After(from) {
+		from = today
+	}
+
+	var games []models.ScheduleGame
+	if !from.After(end) {
+		scheduled, err := repositories.GetScheduleGamesBetween(from.Format(lineupDateLayout), end.Format(lineupDateLayout))
+		if err != nil {
+			return nil, err
+		}
+		// Games already under way count through the actual totals
+		for _, game := range scheduled {
+			if game.StartTimeUTC.After(now) {
+				games = append(games, game)
+			}
+		}
+	}
+	remaining := gamesPerTeam(games)
+	opponents := opponentsByDate(games)
+
+	windowDays := WaiverWindows[defaultTradeWindow]
+	var active []models.RosterPlayer
+	for _, player := range append(teamRoster.Players, opponentRoster.Players...) {
+		if !isReserveSlot(player.SelectedPosition) && remaining[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)] > 0 {
+			active = append(active, player)
+		}
+	}
+	rates, err := projectPlayerRates(sessionId, leagueId, settings.StatModifiers, active, windowDays, remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := ScoredCategories(settings)
+
+	team := ProjectMatchupSide(matchup.Team, settings.RosterPositions, teamRoster.Players, rates, categories, opponents, from, end)
+	opponent := ProjectMatchupSide(matchup.Opponent, settings.RosterPositions, opponentRoster.Players, rates, categories, opponents, from, end)
+
+	rng := rand.New(rand.NewSource(now.UnixNano()))
+	result := SimulateMatchup(team, opponent, categories, simulations, rng)
+
+	preview := &models.MatchupPreview{
+		LeagueKey:       leagueId,
+		Week:            weekNumber,
+		Status:          matchup.Status,
+		WeekStart:       start.Format(lineupDateLayout),
+		WeekEnd:         end.Format(lineupDateLayout),
+		ProjectedFrom:   now.UTC().Format(time.RFC3339),
+		ScoringType:     "points",
+		Simulations:     simulations,
+		Team:            previewSide(matchup.Team, team),
+		Opponent:        previewSide(matchup.Opponent, opponent),
+		Categories:      []models.PreviewCategory{},
+		WinProbability:  utils.RoundFloat(result.Win, 4),
+		TieProbability:  utils.RoundFloat(result.Tie, 4),
+		LossProbability: utils.RoundFloat(result.Loss, 4),
+		GeneratedAt:     now,
+	}
+
+	if len(categories) > 0 {
+		preview.ScoringType = "categories"
+		preview.ExpectedCategoryWins = utils.RoundFloat(result.ExpectedWins, 2)
+		preview.ExpectedCategoryLosses = utils.RoundFloat(result.ExpectedLosses, 2)
+		for _, category := range categories {
+			statID := category.StatID
+			preview.Categories = append(preview.Categories, models.PreviewCategory{
+				StatID:            statID,
+				Name:              category.Name,
+				LowerIsBetter:     category.LowerIsBetter,
+				Projected:         !rateStatIDs[statID],
+				TeamActual:        team.ActualStats[statID],
+				OpponentActual:    opponent.ActualStats[statID],
+				TeamProjected:     utils.RoundFloat(team.ActualStats[statID]+team.RemainingStats[statID], 2),
+				OpponentProjected: utils.RoundFloat(opponent.ActualStats[statID]+opponent.RemainingStats[statID], 2),
+				WinProbability:    utils.RoundFloat(result.CategoryWins[statID], 4),
+			})
+		}
+	}
+
+	return preview, nil
+}
+
+// ScoredCategories returns the categories a head-to-head categories league scores, in settings
+// order. Points leagues and display-only stats have none.
+func ScoredCategories(settings *models.League) []models.StatCategory {
+	if len(settings.StatModifiers) > 0 {
+		return nil
+	}
+
+	var categories []models.StatCategory
+	for _, category := range settings.StatCategories {
+		if !category.IsOnlyDisplayStat {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+func previewSide(team models.MatchupTeam, projection SideProjection) models.PreviewSide {
+	return models.PreviewSide{
+		TeamKey:              team.TeamKey,
+		Name:                 team.Name,
+		ActualPoints:         projection.ActualPoints,
+		RemainingPoints:      utils.RoundFloat(projection.RemainingPoints, 2),
+		ProjectedPoints:      utils.RoundFloat(projection.ActualPoints+projection.RemainingPoints, 2),
+		RemainingStarts:      projection.RemainingStarts,
+		YahooProjectedPoints: team.ProjectedPoints,
+	}
+}
+
+// ProjectMatchupSide adds a team's projected production over the remaining games to what it has
+// scored so far. In a categories league every player with a game is worth the same to the lineup
+// optimizer, so the projection maximises starts.
+func ProjectMatchupSide(team models.MatchupTeam, positions []models.RosterPosition, players []models.RosterPlayer, rates map[string]PlayerRate, categories []models.StatCategory, opponents map[string]map[string]string, from, to time.Time) SideProjection {
+	side := SideProjection{
+		ActualPoints:   team.Points,
+		ActualStats:    make(map[string]float64),
+		RemainingStats: make(map[string]float64),
+	}
+	for _, stat := range team.Stats {
+		if value, err := strconv.ParseFloat(stat.Value, 64); err == nil {
+			side.ActualStats[stat.StatID] = value
+		}
+	}
+
+	perGame := make(map[string]float64)
+	for _, player := range players {
+		if len(categories) > 0 {
+			perGame[player.PlayerKey] = 1
+		} else {
+			perGame[player.PlayerKey] = rates[player.PlayerKey].PointsPerGame
+		}
+	}
+
+	side.StartsPerPlayer = ProjectRemainingStarts(positions, players, perGame, opponents, from, to)
+	for playerKey, starts := range side.StartsPerPlayer {
+		rate := rates[playerKey]
+		side.RemainingStarts += starts
+		side.RemainingPoints += rate.PointsPerGame * float64(starts)
+		// A coefficient of variation of one per game: single-game fantasy output is about as
+		// spread out as it is large
+		side.PointsVariance += rate.PointsPerGame * rate.PointsPerGame * float64(starts)
+		for _, category := range categories {
+			if !rateStatIDs[category.StatID] {
+				side.RemainingStats[category.StatID] += rate.Categories[category.StatID] * float64(starts)
+			}
+		}
+	}
+
+	return side
+}
+
+// ProjectRemainingStarts counts how many games each player starts in the optimal daily lineups
+// between two dates
+func ProjectRemainingStarts(positions []models.RosterPosition, players []models.RosterPlayer, perGame map[string]float64, opponents map[string]map[string]string, from, to time.Time) map[string]int {
+	starts := make(map[string]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format(lineupDateLayout)
+		lineup := OptimizeLineupForDay(date, positions, players, perGame, opponents[date])
+		for _, slot := range lineup.Starters {
+			if slot.PlayerKey != "" {
+				starts[slot.PlayerKey]++
+			}
+		}
+	}
+	return starts
+}
+
+// SimulateMatchup plays out the rest of the week many times. Points totals are drawn from a normal
+// around the projection; counting categories from a Poisson-like normal rounded to whole numbers.
+// Rate categories cannot be projected from per-game rates and keep their current values. A
+// categories matchup is won by taking more categories than the opponent.
+func SimulateMatchup(team, opponent SideProjection, categories []models.StatCategory, simulations int, rng *rand.Rand) MatchupSimulation {
+	result := MatchupSimulation{CategoryWins: make(map[string]float64)}
+	if simulations <= 0 {
+		return result
+	}
+
+	wins, ties, losses := 0, 0, 0
+	categoryWins := make(map[string]float64)
+	outrightWins, outrightLosses := 0, 0
+	for i := 0; i < simulations; i++ {
+		var outcome int
+		if len(categories) == 0 {
+			teamTotal := team.ActualPoints + drawNormal(rng, team.RemainingPoints, team.PointsVariance)
+			opponentTotal := opponent.ActualPoints + drawNormal(rng, opponent.RemainingPoints, opponent.PointsVariance)
+			outcome = compareTotals(teamTotal, opponentTotal, false)
+		} else {
+			won, lost := 0, 0
+			for _, category := range categories {
+				statID := category.StatID
+				teamTotal := team.ActualStats[statID] + drawCategory(rng, statID, team.RemainingStats[statID])
+				opponentTotal := opponent.ActualStats[statID] + drawCategory(rng, statID, opponent.RemainingStats[statID])
+				switch compareTotals(teamTotal, opponentTotal, category.LowerIsBetter) {
+				case 1:
+					won++
+					categoryWins[statID]++
+				case -1:
+					lost++
+				default:
+					categoryWins[statID] += 0.5
+				}
+			}
+			outrightWins += won
+			outrightLosses += lost
+			outcome = compareTotals(float64(won), float64(lost), false)
+		}
+
+		switch outcome {
+		case 1:
+			wins++
+		case -1:
+			losses++
+		default:
+			ties++
+		}
+	}
+
+	n := float64(simulations)
+	result.Win = float64(wins) / n
+	result.Tie = float64(ties) / n
+	result.Loss = float64(losses) / n
+	for _, category := range categories {
+		result.CategoryWins[category.StatID] = categoryWins[category.StatID] / n
+	}
+	// Expected wins and losses count outright results only, leaving ties to neither
+	result.ExpectedWins = float64(outrightWins) / n
+	result.ExpectedLosses = float64(outrightLosses) / n
+
+	return result
+}
+
+// compareTotals returns 1 when a beats b, -1 when it loses and 0 on a tie
+func compareTotals(a, b float64, lowerIsBetter bool) int {
+	if lowerIsBetter {
+		a, b = b, a
+	}
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func drawNormal(rng *rand.Rand, mean, variance float64) float64 {
+	if variance <= 0 {
+		return mean
+	}
+	return mean + rng.NormFloat64()*math.Sqrt(variance)
+}
+
+// drawCategory draws a category's remaining total with the variance of a Poisson count. Plus/minus
+// can go negative; every other counting stat is clamped at zero.
+func drawCategory(rng *rand.Rand, statID string, mean float64) float64 {
+	if rateStatIDs[statID] || mean == 0 {
+		return 0
+	}
+	value := math.Round(drawNormal(rng, mean, math.Abs(mean)))
+	if value < 0 && statID != "4" {
+		return 0
+	}
+	return value
+}
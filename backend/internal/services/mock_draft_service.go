@@ -0,0 +1,418 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	nhlSeasonGames       = 82
+	mockDraftPoolFactor  = 1.5 // Players ranked per pick in the draft
+	maxMockDraftPool     = 400
+	draftRecommendations = 10
+	draftBenchFactor     = 0.5 // A bench pick is worth half a starter
+	draftDropOffWeight   = 0.5 // Share of the positional drop-off added to a pick's score
+)
+
+// CreateMockDraft ranks the league's preseason player pool by our projections under the league's
+// scoring and saves it with a new draft, so picks can be entered and resumed without Yahoo
+func CreateMockDraft(sessionId, leagueId string, request models.MockDraftRequest) (*models.MockDraftState, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.NumTeams <= 0 {
+		return nil, fmt.Errorf("league %s has no teams", leagueId)
+	}
+	if request.DraftPosition < 1 || request.DraftPosition > settings.NumTeams {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("draft position must be between 1 and %d", settings.NumTeams))
+	}
+
+	rounds := request.Rounds
+	if rounds == 0 {
+		rounds = draftRosterSize(settings.RosterPositions)
+	}
+	if rounds <= 0 {
+		return nil, utils.NewBadRequestError("rounds must be positive")
+	}
+
+	poolSize := int(math.Ceil(float64(rounds*settings.NumTeams) * mockDraftPoolFactor))
+	if poolSize > maxMockDraftPool {
+		poolSize = maxMockDraftPool
+	}
+	pool, err := GetLeaguePlayersByPreseasonRank(sessionId, leagueId, poolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := repositories.GetPlayerIDMappings()
+	if err != nil {
+		return nil, err
+	}
+	nhlIds := make(map[string]string)
+	for _, mapping := range mappings {
+		nhlIds[utils.YahooPlayerID(mapping.YahooPlayerID)] = mapping.NHLPlayerID
+	}
+
+	seasons := utils.PreviousNhlSeasons(utils.GetCurrentNhlSeason(), baselineSeasons)
+	since := currentSeasonStart()
+	if len(seasons) > 0 {
+		since = seasons[len(seasons)-1][:4] + "-07-01"
+	}
+	stored, err := repositories.GetPlayerGameStatsSince(since)
+	if err != nil {
+		return nil, err
+	}
+	gameLogs := make(map[string][]*models.PlayerGameStat)
+	for _, game := range stored {
+		gameLogs[game.PlayerID] = append(gameLogs[game.PlayerID], game)
+	}
+
+	players := make([]models.MockDraftPlayer, 0, len(pool))
+	for _, player := range pool {
+		players = append(players, projectDraftPlayer(settings.StatModifiers, player, gameLogs[nhlIds[utils.YahooPlayerID(player.PlayerKey)]]))
+	}
+	players = RankDraftPlayers(players)
+
+	draft := models.MockDraft{
+		LeagueKey:       leagueId,
+		Teams:           settings.NumTeams,
+		Rounds:          rounds,
+		DraftPosition:   request.DraftPosition,
+		RosterPositions: settings.RosterPositions,
+	}
+	if err := repositories.CreateMockDraft(&draft, players); err != nil {
+		return nil, err
+	}
+
+	state := BuildMockDraftState(draft, players, nil)
+	return &state, nil
+}
+
+// GetMockDraft resumes a saved mock draft
+func GetMockDraft(draftId uint) (*models.MockDraftState, error) {
+	draft, players, picks, err := loadMockDraft(draftId)
+	if err != nil {
+		return nil, err
+	}
+
+	state := BuildMockDraftState(*draft, players, picks)
+	return &state, nil
+}
+
+// RecordMockDraftPick enters the next pick of a mock draft for whichever team is on the clock
+func RecordMockDraftPick(draftId uint, playerKey string) (*models.MockDraftState, error) {
+	draft, players, picks, err := loadMockDraft(draftId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(picks) >= draft.Teams*draft.Rounds {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("mock draft %d is complete", draftId))
+	}
+
+	inPool := false
+	for _, player := range players {
+		if player.PlayerKey == playerKey {
+			inPool = true
+			break
+		}
+	}
+	if !inPool {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("player %s is not in the draft pool", playerKey))
+	}
+	for _, pick := range picks {
+		if pick.PlayerKey == playerKey {
+			return nil, utils.NewBadRequestError(fmt.Sprintf("player %s was already taken with pick %d", playerKey, pick.Pick))
+		}
+	}
+
+	pick := models.MockDraftPick{
+		DraftID:   draftId,
+		Pick:      len(picks) + 1,
+		PlayerKey: playerKey,
+	}
+	pick.Round, pick.TeamSlot = SnakeDraftSlot(pick.Pick, draft.Teams)
+	if err := repositories.SaveMockDraftPick(pick); err != nil {
+		return nil, err
+	}
+
+	state := BuildMockDraftState(*draft, players, append(picks, pick))
+	return &state, nil
+}
+
+// UndoMockDraftPick removes the latest pick of a mock draft
+func UndoMockDraftPick(draftId uint) (*models.MockDraftState, error) {
+	draft, players, picks, err := loadMockDraft(draftId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(picks) == 0 {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("mock draft %d has no picks to undo", draftId))
+	}
+	if err := repositories.DeleteMockDraftPick(draftId, picks[len(picks)-1].Pick); err != nil {
+		return nil, err
+	}
+
+	state := BuildMockDraftState(*draft, players, picks[:len(picks)-1])
+	return &state, nil
+}
+
+// BuildMockDraftState works out who is on the clock, the user's roster and open slots, and what
+// to take with the user's next pick
+func BuildMockDraftState(draft models.MockDraft, players []models.MockDraftPlayer, picks []models.MockDraftPick) models.MockDraftState {
+	state := models.MockDraftState{
+		Draft:           draft,
+		UserRoster:      []models.MockDraftPlayer{},
+		Picks:           []models.MockDraftPick{},
+		Recommendations: []models.DraftRecommendation{},
+	}
+
+	byKey := make(map[string]models.MockDraftPlayer)
+	for _, player := range players {
+		byKey[player.PlayerKey] = player
+	}
+
+	drafted := make(map[string]bool)
+	for _, pick := range picks {
+		pick.Name = byKey[pick.PlayerKey].Name
+		state.Picks = append(state.Picks, pick)
+		drafted[pick.PlayerKey] = true
+		if pick.TeamSlot == draft.DraftPosition {
+			state.UserRoster = append(state.UserRoster, byKey[pick.PlayerKey])
+		}
+	}
+	state.OpenSlots = OpenRosterSlots(draft.RosterPositions, state.UserRoster)
+
+	total := draft.Teams * draft.Rounds
+	state.CurrentPick = len(picks) + 1
+	if state.CurrentPick > total {
+		state.Complete = true
+		return state
+	}
+	state.Round, state.TeamSlot = SnakeDraftSlot(state.CurrentPick, draft.Teams)
+	state.IsUserPick = state.TeamSlot == draft.DraftPosition
+
+	nextUser := nextUserPick(draft, state.CurrentPick)
+	if nextUser == 0 {
+		return state
+	}
+	state.PicksUntilUser = nextUser - state.CurrentPick
+
+	// Players other teams take before the user picks again after this one
+	gap := 0
+	if following := nextUserPick(draft, nextUser+1); following != 0 {
+		gap = following - nextUser - 1
+	}
+
+	var available []models.MockDraftPlayer
+	for _, player := range players {
+		if !drafted[player.PlayerKey] {
+			available = append(available, player)
+		}
+	}
+	state.Recommendations = RecommendDraftPicks(draft.RosterPositions, available, state.UserRoster, gap, draftRecommendations)
+
+	return state
+}
+
+// RecommendDraftPicks scores available players by their projection, halved for bench picks, plus
+// part of the drop-off to the best player at the same slot expected to survive until the user's
+// following pick, assuming the other teams take the best ranked players in between
+func RecommendDraftPicks(positions []models.RosterPosition, available []models.MockDraftPlayer, roster []models.MockDraftPlayer, gap, count int) []models.DraftRecommendation {
+	open := OpenRosterSlots(positions, roster)
+
+	recommendations := []models.DraftRecommendation{}
+	for _, candidate := range available {
+		slot := draftFillSlot(positions, open, candidate)
+		if slot == "" {
+			continue
+		}
+
+		replacement := 0.0
+		skipped := 0
+		for _, other := range available {
+			if other.PlayerKey == candidate.PlayerKey {
+				continue
+			}
+			if skipped < gap {
+				skipped++
+				continue
+			}
+			if draftCanFill(other, slot) && other.ProjectedPoints > replacement {
+				replacement = other.ProjectedPoints
+			}
+		}
+		dropOff := math.Max(0, candidate.ProjectedPoints-replacement)
+
+		need := 1.0
+		reason := fmt.Sprintf("Fills %s; %.1f points ahead of the best %s likely left at your next pick", slot, dropOff, slot)
+		if slot == "BN" {
+			need = draftBenchFactor
+			reason = fmt.Sprintf("Bench depth with starting slots filled; %.1f points ahead of the best player likely left at your next pick", dropOff)
+		}
+
+		recommendations = append(recommendations, models.DraftRecommendation{
+			PlayerKey:         candidate.PlayerKey,
+			Name:              candidate.Name,
+			EligiblePositions: candidate.EligiblePositions,
+			Rank:              candidate.Rank,
+			ProjectedPoints:   candidate.ProjectedPoints,
+			Slot:              slot,
+			DropOff:           utils.RoundFloat(dropOff, 2),
+			Score:             utils.RoundFloat(need*(candidate.ProjectedPoints+draftDropOffWeight*dropOff), 2),
+			Reason:            reason,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool { return recommendations[i].Score > recommendations[j].Score })
+	if len(recommendations) > count {
+		recommendations = recommendations[:count]
+	}
+	return recommendations
+}
+
+// OpenRosterSlots places drafted players into the league's active roster slots, dedicated
+// positions first, then Util, then the bench, and returns the slots still open
+func OpenRosterSlots(positions []models.RosterPosition, roster []models.MockDraftPlayer) map[string]int {
+	open := make(map[string]int)
+	for _, position := range positions {
+		if !isReserveSlot(position.Position) {
+			open[position.Position] += position.Count
+		}
+	}
+
+	for _, player := range roster {
+		if slot := draftFillSlot(positions, open, player); slot != "" {
+			open[slot]--
+		}
+	}
+
+	for position, count := range open {
+		if count <= 0 {
+			delete(open, position)
+		}
+	}
+	return open
+}
+
+// RankDraftPlayers orders a pool by projected points, keeping the pool's order for ties
+func RankDraftPlayers(players []models.MockDraftPlayer) []models.MockDraftPlayer {
+	ranked := append([]models.MockDraftPlayer(nil), players...)
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].ProjectedPoints > ranked[j].ProjectedPoints })
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+	}
+	return ranked
+}
+
+// SnakeDraftSlot returns the round and team slot of an overall pick, reversing every other round
+func SnakeDraftSlot(pick, teams int) (int, int) {
+	round := (pick-1)/teams + 1
+	slot := (pick-1)%teams + 1
+	if round%2 == 0 {
+		slot = teams - slot + 1
+	}
+	return round, slot
+}
+
+func loadMockDraft(draftId uint) (*models.MockDraft, []models.MockDraftPlayer, []models.MockDraftPick, error) {
+	draft, err := repositories.GetMockDraft(draftId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if draft == nil {
+		return nil, nil, nil, utils.NewNotFoundError(fmt.Sprintf("mock draft %d not found", draftId))
+	}
+
+	players, err := repositories.GetMockDraftPlayers(draftId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	picks, err := repositories.GetMockDraftPicks(draftId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return draft, players, picks, nil
+}
+
+// projectDraftPlayer projects a full season from the player's game log. Before the season starts
+// the projection rests entirely on the prior seasons' baseline.
+func projectDraftPlayer(statModifiers []models.StatModifier, player models.Player, games []*models.PlayerGameStat) models.MockDraftPlayer {
+	projection := BuildPlayerProjection(statModifiers, currentSeasonGames(games), nhlSeasonGames)
+	ApplyProjectionBaseline(statModifiers, &projection, priorSeasonGames(games, baselineSeasons))
+
+	return models.MockDraftPlayer{
+		PlayerKey:         player.PlayerKey,
+		Name:              player.Name.FullName,
+		Team:              player.TeamAbbreviation,
+		EligiblePositions: player.EligiblePositions,
+		PositionType:      player.PositionType,
+		PointsPerGame:     projection.PointsPerGame,
+		ProjectedPoints:   projection.RestOfSeasonPoints,
+	}
+}
+
+// draftFillSlot picks the open slot a player would take: a dedicated position in roster order,
+// then Util, then the bench. It returns "" when the player fits nowhere.
+func draftFillSlot(positions []models.RosterPosition, open map[string]int, player models.MockDraftPlayer) string {
+	for _, position := range positions {
+		slot := position.Position
+		if slot == "Util" || slot == "BN" || isReserveSlot(slot) {
+			continue
+		}
+		if open[slot] > 0 && draftCanFill(player, slot) {
+			return slot
+		}
+	}
+	for _, slot := range []string{"Util", "BN"} {
+		if open[slot] > 0 && draftCanFill(player, slot) {
+			return slot
+		}
+	}
+	return ""
+}
+
+// draftCanFill reports whether a player may be drafted into a slot. Util takes any skater and
+// the bench anyone.
+func draftCanFill(player models.MockDraftPlayer, slot string) bool {
+	if slot == "BN" || (slot == "Util" && player.PositionType == "P") {
+		return true
+	}
+	for _, eligible := range player.EligiblePositions {
+		if eligible == slot {
+			return true
+		}
+	}
+	return false
+}
+
+// draftRosterSize counts the roster slots filled at the draft, leaving out injured and minors slots
+func draftRosterSize(positions []models.RosterPosition) int {
+	size := 0
+	for _, position := range positions {
+		if !isReserveSlot(position.Position) {
+			size += position.Count
+		}
+	}
+	return size
+}
+
+// nextUserPick returns the user's first pick at or after from, or 0 when they have none left
+func nextUserPick(draft models.MockDraft, from int) int {
+	for pick := from; pick <= draft.Teams*draft.Rounds; pick++ {
+		if _, slot := SnakeDraftSlot(pick, draft.Teams); slot == draft.DraftPosition {
+			return pick
+		}
+	}
+	return 0
+}
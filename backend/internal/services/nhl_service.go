@@ -3,6 +3,8 @@ package services
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
@@ -38,36 +40,112 @@ func GetTeamSchedule(abbr string) error {
 		return fmt.Errorf("unexpected response format for team %s", abbr)
 	}
 
+	now := time.Now()
 	for _, gameData := range gamesData {
 		gameMap, ok := gameData.(map[string]interface{})
 		if !ok {
 			continue // Skip invalid entries
 		}
 
-		startTimeStr := gameMap["startTimeUTC"].(string)
-		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		schedule, err := MapScheduleGame(gameMap)
 		if err != nil {
-			return fmt.Errorf("failed to parse start time for game ID %v: %w", gameMap["id"], err)
+			return err
+		}
+
+		// Each game shows up in both teams' schedules, so the second sync finds nothing new
+		stored, err := repositories.GetScheduleGameByID(schedule.ID)
+		if err != nil {
+			return err
 		}
 
-		schedule := models.ScheduleGame{
-			ID:             int64(gameMap["id"].(float64)),
-			Season:         int(gameMap["season"].(float64)),
-			GameType:       int(gameMap["gameType"].(float64)),
-			GameDate:       gameMap["gameDate"].(string),
-			StartTimeUTC:   startTime, // Use parsed time
-			HomeTeamAbbrev: gameMap["homeTeam"].(map[string]interface{})["abbrev"].(string),
-			AwayTeamAbbrev: gameMap["awayTeam"].(map[string]interface{})["abbrev"].(string),
+		changes := DiffScheduleGame(stored, schedule, now)
+		if stored != nil {
+			schedule.Rescheduled = stored.Rescheduled
+		}
+		for _, change := range changes {
+			if change.ChangeType == models.ScheduleChangeRescheduled {
+				schedule.Rescheduled = true
+			}
 		}
 
-		if err := repositories.SaveScheduleGameInDB(&schedule); err != nil {
+		if err := repositories.SaveScheduleGameInDB(schedule); err != nil {
 			return fmt.Errorf("failed to save schedule for game ID %d: %w", schedule.ID, err)
 		}
+		if err := repositories.SaveScheduleChanges(changes); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// GetScheduleChanges returns the schedule changes found by syncs since a date, optionally for one team
+func GetScheduleChanges(since, teamAbbrev string) ([]models.ScheduleChange, error) {
+	sinceDate, err := time.Parse(lineupDateLayout, since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %s: %w", since, err)
+	}
+
+	changes, err := repositories.GetScheduleChanges(sinceDate, strings.ToUpper(teamAbbrev))
+	if err != nil {
+		return nil, err
+	}
+	if changes == nil {
+		changes = []models.ScheduleChange{}
+	}
+	return changes, nil
+}
+
+// DiffScheduleGame lists what a sync changed about a stored game: a new date or start time, a
+// schedule state change such as a postponement, or a final result. New games have no changes.
+func DiffScheduleGame(stored *models.ScheduleGame, synced *models.ScheduleGame, detectedAt time.Time) []models.ScheduleChange {
+	if stored == nil {
+		return nil
+	}
+
+	var changes []models.ScheduleChange
+	change := func(changeType, oldValue, newValue string) {
+		changes = append(changes, models.ScheduleChange{
+			GameID:         synced.ID,
+			GameDate:       synced.GameDate,
+			HomeTeamAbbrev: synced.HomeTeamAbbrev,
+			AwayTeamAbbrev: synced.AwayTeamAbbrev,
+			ChangeType:     changeType,
+			OldValue:       oldValue,
+			NewValue:       newValue,
+			DetectedAt:     detectedAt,
+		})
+	}
+
+	if !stored.StartTimeUTC.Equal(synced.StartTimeUTC) || stored.GameDate != synced.GameDate {
+		change(models.ScheduleChangeRescheduled, stored.StartTimeUTC.UTC().Format(time.RFC3339), synced.StartTimeUTC.UTC().Format(time.RFC3339))
+	}
+
+	// Games stored before states were synced have none, which is not a change worth reporting
+	if stored.ScheduleState != "" && stored.ScheduleState != synced.ScheduleState {
+		change(models.ScheduleChangeStatus, stored.ScheduleState, synced.ScheduleState)
+	}
+
+	if !isFinalGameState(stored.GameState) && isFinalGameState(synced.GameState) {
+		change(models.ScheduleChangeFinal, "", formatFinalScore(synced))
+	}
+
+	return changes
+}
+
+func isFinalGameState(state string) bool {
+	return state == "FINAL" || state == "OFF"
+}
+
+// formatFinalScore renders a result as "TOR 3 - 2 MTL (OT)"
+func formatFinalScore(game *models.ScheduleGame) string {
+	score := fmt.Sprintf("%s %d - %d %s", game.AwayTeamAbbrev, game.AwayScore, game.HomeScore, game.HomeTeamAbbrev)
+	if game.LastPeriodType == "OT" || game.LastPeriodType == "SO" {
+		score += fmt.Sprintf(" (%s)", game.LastPeriodType)
+	}
+	return score
+}
+
 func GetTeamRoster(teamAbrev, season string) ([]*models.NHLPlayer, error) {
 	url := fmt.Sprintf("https://api-web.nhle.com/v1/roster/%s/%s", teamAbrev, season)
 
@@ -157,8 +235,13 @@ func MapNhlPlayerToYahoo() error {
 	return nil
 }
 
-func GetPlayerGameStatsNHL(playerId, season string) ([]*models.PlayerGameStat, error) {
-	url := fmt.Sprintf("https://api-web.nhle.com/v1/player/%s/game-log/%s/2", playerId, season)
+// GetPlayerGameStatsNHL fetches and stores a player's game log for a season and game type
+func GetPlayerGameStatsNHL(playerId, season string, gameType int) ([]*models.PlayerGameStat, error) {
+	url := fmt.Sprintf("https://api-web.nhle.com/v1/player/%s/game-log/%s/%d", playerId, season, gameType)
+	seasonId, err := strconv.Atoi(season)
+	if err != nil {
+		return nil, fmt.Errorf("invalid season %s: %w", season, err)
+	}
 
 	response, err := GetHttpRequest(url)
 	if err != nil {
@@ -174,6 +257,8 @@ func GetPlayerGameStatsNHL(playerId, season string) ([]*models.PlayerGameStat, e
 				return nil, err
 			}
 			game.PlayerID = playerId
+			game.Season = seasonId
+			game.GameType = gameType
 			playerGameStats = append(playerGameStats, game)
 		}
 	}
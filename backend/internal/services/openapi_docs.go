@@ -0,0 +1,127 @@
+package services
+
+import (
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+)
+
+// legacy marks the documentation of a pre-/api/v1 alias as deprecated.
+func legacy(doc RouteDoc) RouteDoc {
+	doc.Deprecated = true
+	return doc
+}
+
+var (
+	userLeaguesDoc       = RouteDoc{Summary: "List the user's fantasy leagues", Tag: "Leagues", Session: true, Details: map[string]interface{}{}}
+	leagueInfoDoc        = RouteDoc{Summary: "Get league metadata from Yahoo", Tag: "Leagues", Session: true, Details: map[string]interface{}{}}
+	leagueSettingsDoc    = RouteDoc{Summary: "Get league settings, roster positions and stat modifiers", Tag: "Leagues", Session: true, Details: models.League{}}
+	leagueTeamsDoc       = RouteDoc{Summary: "List the fantasy teams in a league", Tag: "Leagues", Session: true, Details: []models.LeagueTeam{}}
+	leaguePlayerStatsDoc = RouteDoc{Summary: "Get a player's stats weighted by league stat modifiers", Tag: "Leagues", Session: true, Details: models.LeaguePlayerStatsResponse{}}
+	playerRanksDoc       = RouteDoc{Summary: "Get a player's ranks within a league", Tag: "Leagues", Session: true, Details: models.PlayerRanksResponse{}}
+
+	teamMatchupsDoc = RouteDoc{Summary: "Get and store a fantasy team's matchups", Tag: "Teams", Session: true, Details: TeamMatchupResponse{}}
+	teamWeeklyDoc   = RouteDoc{Summary: "Get a fantasy team's projected and final points per week", Tag: "Teams", Session: true, Details: map[string]map[string]string{}}
+
+	playerSearchDoc = RouteDoc{Summary: "Find a player by full name", Tag: "Players", Session: true, Details: models.PlayerDetails{}}
+	playerSyncDoc   = RouteDoc{Summary: "Fetch every NHL player from Yahoo and store them", Tag: "Players", Session: true, Details: []*models.YahooPlayer{}}
+	playerStatsDoc  = RouteDoc{Summary: "Get a player's season stats", Tag: "Players", Session: true, Details: models.Player{}}
+	playerMapDoc    = RouteDoc{Summary: "Map NHL player ids to Yahoo player ids", Tag: "Players"}
+
+	scheduleSyncDoc = RouteDoc{Summary: "Fetch the season schedule for every NHL team and record start time, state and score changes", Tag: "NHL"}
+	nextGameDoc     = RouteDoc{Summary: "Get the start time of an NHL team's next game", Tag: "NHL", Details: time.Time{}}
+	nhlRosterDoc    = RouteDoc{Summary: "Get and store an NHL team's roster", Tag: "NHL", Details: []*models.NHLPlayer{}}
+	gameLogDoc      = RouteDoc{Summary: "Get and store an NHL player's regular season or playoff game log", Tag: "NHL", Query: []string{"gameType"}, Details: []*models.PlayerGameStat{}}
+
+	clearCacheDoc = RouteDoc{Summary: "Clear cached responses, optionally for a single operation", Tag: "Cache", RequestBody: models.ClearCacheRequest{}}
+)
+
+// routeDocs is keyed by "METHOD path template" and must cover every registered route.
+var routeDocs = map[string]RouteDoc{
+	"GET /health":         {Summary: "Health check", Tag: "Health", ContentType: "text/plain"},
+	"GET /openapi.json":   {Summary: "OpenAPI document for this API", Tag: "Health", ContentType: "application/json"},
+	"GET /login":          {Summary: "Redirect to Yahoo OAuth", Tag: "Auth", Redirect: true},
+	"GET /yahoo-redirect": {Summary: "Yahoo OAuth callback", Tag: "Auth", Query: []string{"code"}, Redirect: true},
+
+	// /api/v1
+	"GET /api/v1/users/me/leagues":                                     userLeaguesDoc,
+	"GET /api/v1/users/me/dashboard":                                   {Summary: "Summarise the user's team, matchup, standing and lineup issues in each active NHL league", Tag: "Leagues", Session: true, Details: models.UserDashboard{}},
+	"GET /api/v1/leagues/{leagueId}":                                   leagueInfoDoc,
+	"GET /api/v1/leagues/{leagueId}/settings":                          leagueSettingsDoc,
+	"GET /api/v1/leagues/{leagueId}/teams":                             leagueTeamsDoc,
+	"GET /api/v1/leagues/{leagueId}/standings":                         {Summary: "Get and store league standings with power rankings", Tag: "Leagues", Session: true, Details: models.LeagueStandingsResponse{}},
+	"GET /api/v1/leagues/{leagueId}/luck":                              {Summary: "Compare actual records with all-play records to measure luck", Tag: "Leagues", Session: true, Details: models.LeagueLuckResponse{}},
+	"GET /api/v1/leagues/{leagueId}/categories":                        {Summary: "Aggregate category wins, losses and trends per team", Tag: "Leagues", Session: true, Details: models.LeagueCategoryResponse{}},
+	"GET /api/v1/leagues/{leagueId}/rosters":                           {Summary: "Get and store the lineup of every team in a league", Tag: "Leagues", Session: true, Query: []string{"week", "date"}, Details: []*models.TeamRoster{}},
+	"GET /api/v1/leagues/{leagueId}/injuries":                          {Summary: "List injured and unavailable players on every roster in a league", Tag: "Leagues", Session: true, Details: []models.TeamInjuries{}},
+	"GET /api/v1/leagues/{leagueId}/schedule":                          {Summary: "Count NHL games per team and per night in a fantasy week", Tag: "Leagues", Session: true, Query: []string{"week"}, Details: models.WeekSchedule{}},
+	"GET /api/v1/leagues/{leagueId}/compare":                           {Summary: "Compare players side by side with per-stat leaders", Tag: "Leagues", Session: true, Query: []string{"players"}, Details: models.PlayerComparison{}},
+	"POST /api/v1/leagues/{leagueId}/trade-analysis":                   {Summary: "Project both sides of a trade for the rest of the season", Tag: "Leagues", Session: true, RequestBody: models.TradeAnalysisRequest{}, Details: models.TradeAnalysis{}},
+	"GET /api/v1/leagues/{leagueId}/transactions":                      {Summary: "Sync and list a league's adds, drops, trades and waiver claims", Tag: "Leagues", Session: true, Query: []string{"type", "team", "player", "since", "until"}, Details: []models.Transaction{}},
+	"GET /api/v1/leagues/{leagueId}/transactions/analysis":             {Summary: "Rank most added players, manager activity and points gained from pickups", Tag: "Leagues", Session: true, Details: models.TransactionAnalysis{}},
+	"GET /api/v1/leagues/{leagueId}/draft-results":                     {Summary: "List a league's draft picks, stored once the draft is complete", Tag: "Leagues", Session: true, Details: []models.DraftPick{}},
+	"GET /api/v1/leagues/{leagueId}/draft/analysis":                    {Summary: "Grade draft picks and managers by season points versus draft slot", Tag: "Leagues", Session: true, Details: models.DraftAnalysis{}},
+	"GET /api/v1/leagues/{leagueId}/history":                           {Summary: "All-time seasons, manager careers and head-to-head records across the renewed league chain", Tag: "Leagues", Session: true, Query: []string{"manager"}, Details: models.LeagueHistory{}},
+	"POST /api/v1/leagues/{leagueId}/history/sync":                     {Summary: "Follow the renewed league chain and archive each season's settings, standings and matchups", Tag: "Leagues", Session: true, Details: []models.SeasonSummary{}},
+	"POST /api/v1/leagues/{leagueId}/mock-drafts":                      {Summary: "Rank the preseason player pool by projection and start a mock draft", Tag: "Drafts", Session: true, RequestBody: models.MockDraftRequest{}, Details: models.MockDraftState{}},
+	"GET /api/v1/mock-drafts/{draftId}":                                {Summary: "Resume a mock draft with best available recommendations", Tag: "Drafts", Details: models.MockDraftState{}},
+	"POST /api/v1/mock-drafts/{draftId}/picks":                         {Summary: "Enter the next pick of a mock or live draft", Tag: "Drafts", RequestBody: models.MockDraftPickRequest{}, Details: models.MockDraftState{}},
+	"DELETE /api/v1/mock-drafts/{draftId}/picks/last":                  {Summary: "Undo the latest draft pick", Tag: "Drafts", Details: models.MockDraftState{}},
+	"GET /api/v1/leagues/{leagueId}/players/{playerId}/stats":          leaguePlayerStatsDoc,
+	"GET /api/v1/leagues/{leagueId}/players/{playerId}/ranks":          playerRanksDoc,
+	"GET /api/v1/leagues/{leagueId}/players/{playerId}/projection":     {Summary: "Project a player's rest of season from their NHL game log", Tag: "Leagues", Session: true, Details: models.PlayerProjection{}},
+	"GET /api/v1/leagues/{leagueId}/players/{playerId}/fantasy-points": {Summary: "Score a player's NHL game log per game with rolling averages and streaks", Tag: "Leagues", Session: true, Query: []string{"season", "window"}, Details: models.PlayerPointsSeries{}},
+	"GET /api/v1/leagues/{leagueId}/goalies/{playerId}/outlook":        {Summary: "Estimate a goalie's upcoming starts and project their fantasy points", Tag: "Leagues", Session: true, Query: []string{"days"}, Details: models.GoalieOutlook{}},
+	"GET /api/v1/leagues/{leagueId}/projections/backtest":              {Summary: "Backtest game log projections against points scored after a date", Tag: "Leagues", Session: true, Query: []string{"asOf"}, Details: models.ProjectionBacktest{}},
+	"GET /api/v1/teams/{teamId}/matchups":                              teamMatchupsDoc,
+	"GET /api/v1/teams/{teamId}/weekly-stats":                          teamWeeklyDoc,
+	"GET /api/v1/teams/{teamId}/projected-vs-actual":                   {Summary: "Compare projected and actual points per week with player contributions", Tag: "Teams", Session: true, Details: models.ProjectedVsActualStats{}},
+	"GET /api/v1/teams/{teamId}/roster":                                {Summary: "Get and store a fantasy team's lineup for a week or date", Tag: "Teams", Session: true, Query: []string{"week", "date"}, Details: models.TeamRoster{}},
+	"GET /api/v1/teams/{teamId}/roster/history":                        {Summary: "List every stored lineup for a fantasy team", Tag: "Teams", Details: []*models.TeamRoster{}},
+	"GET /api/v1/teams/{teamId}/lineup/optimize":                       {Summary: "Pick the points-maximizing daily lineups for a date range and explain bench decisions", Tag: "Teams", Session: true, Query: []string{"start", "end"}, Details: models.LineupOptimization{}},
+	"GET /api/v1/teams/{teamId}/matchup-preview":                       {Summary: "Project a week's matchup from actual points and remaining games and simulate win probability", Tag: "Teams", Session: true, Query: []string{"week", "simulations"}, Details: models.MatchupPreview{}},
+	"GET /api/v1/teams/{teamId}/games-remaining":                       {Summary: "Count a fantasy team's remaining and usable games in a week", Tag: "Teams", Session: true, Query: []string{"week"}, Details: models.TeamGamesRemaining{}},
+	"GET /api/v1/teams/{teamId}/waiver-recommendations":                {Summary: "Rank free agents as pickups against the team's weakest player at each position", Tag: "Teams", Session: true, Query: []string{"window", "position", "days", "count"}, Details: models.WaiverRecommendations{}},
+	"GET /api/v1/teams/{teamId}/today":                                 {Summary: "List a fantasy team's players with a game on a date and their live stat lines", Tag: "Teams", Session: true, Query: []string{"date"}, Details: models.TeamToday{}},
+	"GET /api/v1/players":                                              playerSearchDoc,
+	"POST /api/v1/players/sync":                                        playerSyncDoc,
+	"GET /api/v1/players/{playerId}/stats":                             playerStatsDoc,
+	"GET /api/v1/players/{playerId}/status-history":                    {Summary: "List a player's recorded Yahoo status changes", Tag: "Players", Details: []models.PlayerStatusChange{}},
+	"POST /api/v1/player-mappings":                                     playerMapDoc,
+	"POST /api/v1/nhl/schedule/sync":                                   scheduleSyncDoc,
+	"GET /api/v1/nhl/scoreboard":                                       {Summary: "Get the stored scoreboard for a date, refreshing games that are not over", Tag: "NHL", Query: []string{"date"}, Details: []*models.LiveGame{}},
+	"POST /api/v1/nhl/scoreboard/sync":                                 {Summary: "Fetch the NHL scoreboard for a date and store each game's state", Tag: "NHL", Query: []string{"date"}, Details: []*models.LiveGame{}},
+	"GET /api/v1/nhl/schedule/changes":                                 {Summary: "List reschedules, postponements and final results found by schedule syncs", Tag: "NHL", Query: []string{"since", "team"}, Details: []models.ScheduleChange{}},
+	"GET /api/v1/nhl/teams/{teamAbrev}/next-game":                      nextGameDoc,
+	"GET /api/v1/nhl/teams/{teamAbrev}/roster":                         nhlRosterDoc,
+	"GET /api/v1/nhl/teams/{teamAbrev}/roster/{season}":                nhlRosterDoc,
+	"GET /api/v1/nhl/players/{playerId}/game-log":                      gameLogDoc,
+	"GET /api/v1/nhl/players/{playerId}/game-log/{season}":             gameLogDoc,
+	"GET /api/v1/nhl/players/{playerId}/seasons":                       {Summary: "Total a player's stored games per season", Tag: "NHL", Query: []string{"gameType"}, Details: []models.GameLogTotals{}},
+	"GET /api/v1/nhl/players/{playerId}/career":                        {Summary: "Total a player's stored games per season and over their career", Tag: "NHL", Details: models.PlayerCareer{}},
+	"POST /api/v1/nhl/game-logs/backfill":                              {Summary: "Start a background fetch of prior seasons' regular season and playoff game logs for every mapped player", Tag: "NHL", Query: []string{"seasons"}, Details: models.GameLogBackfill{}},
+	"GET /api/v1/nhl/game-logs/backfill":                               {Summary: "Report the progress of the latest game log backfill", Tag: "NHL", Details: models.GameLogBackfill{}},
+	"DELETE /api/v1/cache":                                             clearCacheDoc,
+	"DELETE /api/v1/cache/{operation}":                                 clearCacheDoc,
+
+	// Deprecated aliases
+	"GET /get-user-leagues":                                                    legacy(userLeaguesDoc),
+	"GET /get-league-info/{leagueId}":                                          legacy(leagueInfoDoc),
+	"GET /get-league-settings/{leagueId}":                                      legacy(leagueSettingsDoc),
+	"GET /get-team-weekly/team/{teamId}":                                       legacy(teamWeeklyDoc),
+	"GET /get-player-stats/player/{playerId}":                                  legacy(playerStatsDoc),
+	"GET /get-player-rank/league/{leagueId}/player/{playerId}":                 legacy(playerRanksDoc),
+	"GET /get-all-players":                                                     legacy(playerSyncDoc),
+	"GET /get-league-teams/league/{leagueId}":                                  legacy(leagueTeamsDoc),
+	"GET /get-fteam-matchups/team/{teamId}":                                    legacy(teamMatchupsDoc),
+	"GET /get-fantasy-league-player-stats/league/{leagueId}/player/{playerId}": legacy(leaguePlayerStatsDoc),
+	"GET /get-player-by-name/player/{playerName}":                              legacy(playerSearchDoc),
+	"GET /save-all-teams-schedule":                                             legacy(scheduleSyncDoc),
+	"GET /get-next-game/{teamAbrev}":                                           legacy(nextGameDoc),
+	"GET /get-player-game-stats/{playerId}":                                    legacy(gameLogDoc),
+	"GET /get-player-game-stats/{playerId}/season/{season}":                    legacy(gameLogDoc),
+	"GET /get-team-roster/{teamAbrev}":                                         legacy(nhlRosterDoc),
+	"GET /get-team-roster/{teamAbrev}/{season}":                                legacy(nhlRosterDoc),
+	"POST /map-players":                                                        legacy(playerMapDoc),
+	"POST /clear-cache":                                                        legacy(clearCacheDoc),
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+)
+
+// RouteDoc describes a registered route for the OpenAPI document.
+type RouteDoc struct {
+	Summary     string
+	Tag         string
+	Session     bool        // Requires the user-session header
+	Details     interface{} // Zero value of the CustomResponse details payload
+	RequestBody interface{} // Zero value of the JSON request body
+	Query       []string    // Optional query parameters
+	Deprecated  bool
+	Redirect    bool   // Responds with a 302 instead of JSON
+	ContentType string // Set for routes that do not use the CustomResponse envelope
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}:]+)(:[^}]+)?\}`)
+
+type registeredRoute struct {
+	Method   string
+	Path     string
+	QueryVar map[string]string
+}
+
+func (r registeredRoute) key() string {
+	return r.Method + " " + r.Path
+}
+
+func walkRoutes(router *mux.Router) ([]registeredRoute, error) {
+	var registered []registeredRoute
+
+	err := router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		methods, err := route.GetMethods()
+		if err != nil {
+			// Path prefixes for subrouters have no methods
+			return nil
+		}
+
+		pathTemplate, err := route.GetPathTemplate()
+		if err != nil {
+			return fmt.Errorf("failed to get path template: %w", err)
+		}
+
+		queryVars := make(map[string]string)
+		if queries, err := route.GetQueriesTemplates(); err == nil {
+			for _, query := range queries {
+				parts := strings.SplitN(query, "=", 2)
+				if len(parts) == 2 {
+					queryVars[parts[0]] = parts[1]
+				}
+			}
+		}
+
+		for _, method := range methods {
+			registered = append(registered, registeredRoute{
+				Method:   method,
+				Path:     pathParamPattern.ReplaceAllString(pathTemplate, "{$1}"),
+				QueryVar: queryVars,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk routes: %w", err)
+	}
+
+	return registered, nil
+}
+
+// OpenAPIRouteCoverage reports routes without a RouteDoc and RouteDocs without a route.
+func OpenAPIRouteCoverage(router *mux.Router) ([]string, []string, error) {
+	registered, err := walkRoutes(router)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var missing []string
+	seen := make(map[string]bool)
+	for _, route := range registered {
+		seen[route.key()] = true
+		if _, ok := routeDocs[route.key()]; !ok {
+			missing = append(missing, route.key())
+		}
+	}
+
+	var unused []string
+	for key := range routeDocs {
+		if !seen[key] {
+			unused = append(unused, key)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unused)
+	return missing, unused, nil
+}
+
+// BuildOpenAPISpec generates an OpenAPI 3 document from the routes registered on the router.
+func BuildOpenAPISpec(router *mux.Router) (map[string]interface{}, error) {
+	registered, err := walkRoutes(router)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]interface{})
+	schemaForType(reflect.TypeOf(models.CustomResponse{}), schemas)
+
+	paths := make(map[string]interface{})
+	for _, route := range registered {
+		doc, ok := routeDocs[route.key()]
+		if !ok {
+			return nil, fmt.Errorf("missing OpenAPI documentation for route %s", route.key())
+		}
+
+		pathItem, ok := paths[route.Path].(map[string]interface{})
+		if !ok {
+			pathItem = make(map[string]interface{})
+			paths[route.Path] = pathItem
+		}
+		pathItem[strings.ToLower(route.Method)] = buildOperation(route, doc, schemas)
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "Yahoo Fantasy Analyzer API",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}, nil
+}
+
+func buildOperation(route registeredRoute, doc RouteDoc, schemas map[string]interface{}) map[string]interface{} {
+	operation := map[string]interface{}{
+		"summary":     doc.Summary,
+		"operationId": operationID(route),
+		"responses":   buildResponses(doc, schemas),
+	}
+	if doc.Tag != "" {
+		operation["tags"] = []string{doc.Tag}
+	}
+	if doc.Deprecated {
+		operation["deprecated"] = true
+	}
+
+	parameters := []interface{}{}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+
+	queryNames := make([]string, 0, len(route.QueryVar))
+	for name := range route.QueryVar {
+		queryNames = append(queryNames, name)
+	}
+	sort.Strings(queryNames)
+	for _, name := range queryNames {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     name,
+			"in":       "query",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+
+	for _, name := range doc.Query {
+		if _, ok := route.QueryVar[name]; ok {
+			continue
+		}
+		parameters = append(parameters, map[string]interface{}{
+			"name":   name,
+			"in":     "query",
+			"schema": map[string]interface{}{"type": "string"},
+		})
+	}
+
+	if doc.Session {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     "user-session",
+			"in":       "header",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+
+	if doc.RequestBody != nil {
+		operation["requestBody"] = map[string]interface{}{
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": schemaForType(reflect.TypeOf(doc.RequestBody), schemas),
+				},
+			},
+		}
+	}
+
+	return operation
+}
+
+func buildResponses(doc RouteDoc, schemas map[string]interface{}) map[string]interface{} {
+	if doc.Redirect {
+		return map[string]interface{}{
+			"302": map[string]interface{}{"description": "Redirect"},
+		}
+	}
+
+	if doc.ContentType != "" {
+		return map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "OK",
+				"content": map[string]interface{}{
+					doc.ContentType: map[string]interface{}{"schema": map[string]interface{}{}},
+				},
+			},
+		}
+	}
+
+	envelope := map[string]interface{}{"$ref": "#/components/schemas/CustomResponse"}
+	success := envelope
+	if doc.Details != nil {
+		success = map[string]interface{}{
+			"allOf": []interface{}{
+				envelope,
+				map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"details": schemaForType(reflect.TypeOf(doc.Details), schemas),
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"200": map[string]interface{}{
+			"description": "OK",
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": success},
+			},
+		},
+		"default": map[string]interface{}{
+			"description": "Error",
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": envelope},
+			},
+		},
+	}
+}
+
+func operationID(route registeredRoute) string {
+	var builder strings.Builder
+	builder.WriteString(strings.ToLower(route.Method))
+	for _, part := range strings.FieldsFunc(route.Path, func(r rune) bool {
+		return r == '/' || r == '-' || r == '{' || r == '}' || r == '.'
+	}) {
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return builder.String()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaForType mirrors encoding/json so the schema matches what handlers actually write.
+func schemaForType(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{
+			"type":  "array",
+			"items": schemaForType(t.Elem(), schemas),
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": schemaForType(t.Elem(), schemas),
+		}
+	case reflect.Struct:
+		name := t.Name()
+		if name == "" {
+			return structSchema(t, schemas)
+		}
+		if _, exists := schemas[name]; !exists {
+			// Reserve the name first so recursive types terminate
+			schemas[name] = map[string]interface{}{}
+			schemas[name] = structSchema(t, schemas)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func structSchema(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	collectStructProperties(t, properties, schemas)
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+func collectStructProperties(t reflect.Type, properties map[string]interface{}, schemas map[string]interface{}) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+
+		// Untagged embedded structs are flattened by encoding/json
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				collectStructProperties(embedded, properties, schemas)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = schemaForType(field.Type, schemas)
+	}
+}
@@ -0,0 +1,522 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	projectionHalfLifeGames = 10  // A game this many games back counts half as much as the latest
+	usageRecentGames        = 5   // Games used to measure current ice time and power-play usage
+	usageDampening          = 0.5 // Share of a usage change carried into the projection
+	usageFactorMin          = 0.8
+	usageFactorMax          = 1.2
+	minBacktestGames        = 5
+	baselineSeasons         = 2  // Prior seasons used as the multi-year baseline
+	baselineFullGames       = 20 // Prior games count as this many current games at most
+)
+
+// Stats scaled by each usage factor; plus/minus and penalty minutes are left alone
+var (
+	toiScaledStats       = map[string]bool{"1": true, "2": true, "3": true, "9": true, "10": true, "11": true, "12": true, "14": true}
+	powerPlayScaledStats = map[string]bool{"6": true, "7": true, "8": true}
+)
+
+// GetPlayerProjection projects a Yahoo player's rest of season from their stored NHL game log
+func GetPlayerProjection(sessionId, leagueId, playerKey string) (*models.PlayerProjection, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	nhlId, err := nhlPlayerID(playerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := repositories.GetPlayerGameStatsDB(nhlId)
+	if err != nil {
+		return nil, err
+	}
+	lastCompleted, err := lastCompletedGameDates()
+	if err != nil {
+		return nil, err
+	}
+	games, err := currentSeasonGameLog(nhlId, stored, lastCompleted)
+	if err != nil {
+		return nil, err
+	}
+
+	remainingGames := 0
+	if len(games) > 0 {
+		remainingGames, err = teamGamesRemaining(latestTeam(games), settings.EndDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projection := BuildPlayerProjection(settings.StatModifiers, games, remainingGames)
+	ApplyProjectionBaseline(settings.StatModifiers, &projection, priorSeasonGames(stored, baselineSeasons))
+	projection.PlayerKey = playerKey
+	projection.NHLPlayerID = nhlId
+	return &projection, nil
+}
+
+// nhlPlayerID looks up the NHL id mapped to a Yahoo player key from any season. A missing mapping
+// is a NotFoundError; database failures are returned as they are.
+func nhlPlayerID(playerKey string) (string, error) {
+	nhlId, err := repositories.GetNHLPlayerID(playerKey)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", utils.NewNotFoundError(fmt.Sprintf("no NHL player mapped to %s", playerKey))
+	}
+	if err != nil {
+		return "", err
+	}
+	return nhlId, nil
+}
+
+// BacktestProjections projects every player with stored games from the games before asOf and
+// compares the projection with the points they actually scored afterwards
+func BacktestProjections(sessionId, leagueId, asOf string) (*models.ProjectionBacktest, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := repositories.GetPlayerGameStatsSince(currentSeasonStart())
+	if err != nil {
+		return nil, err
+	}
+
+	byPlayer := make(map[string][]*models.PlayerGameStat)
+	for _, game := range currentSeasonGames(games) {
+		byPlayer[game.PlayerID] = append(byPlayer[game.PlayerID], game)
+	}
+
+	var results []models.PlayerBacktest
+	for playerId, playerGames := range byPlayer {
+		result, ok := BacktestPlayer(settings.StatModifiers, playerGames, asOf)
+		if !ok {
+			continue
+		}
+		result.NHLPlayerID = playerId
+		results = append(results, result)
+	}
+
+	return SummarizeBacktest(asOf, results), nil
+}
+
+// BuildPlayerProjection turns a game log into per-game stat rates. Recent games weigh more, then
+// scoring rates are nudged by the change in ice time and power-play production over the last games.
+func BuildPlayerProjection(statModifiers []models.StatModifier, games []*models.PlayerGameStat, remainingGames int) models.PlayerProjection {
+	projection := models.PlayerProjection{
+		TOIFactor:       1,
+		PowerPlayFactor: 1,
+		Stats:           []models.ProjectedStat{},
+		RemainingGames:  remainingGames,
+	}
+
+	sorted := append([]*models.PlayerGameStat(nil), games...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].GameDate < sorted[j].GameDate })
+	projection.GamesSampled = len(sorted)
+	if len(sorted) == 0 {
+		return projection
+	}
+	projection.Team = latestTeam(sorted)
+
+	rates := make(map[string]float64)
+	totalWeight := 0.0
+	weightedTOI := 0.0
+	weightedPowerPlay := 0.0
+	for i, game := range sorted {
+		weight := math.Pow(0.5, float64(len(sorted)-1-i)/projectionHalfLifeGames)
+		totalWeight += weight
+		weightedTOI += weight * parseTOI(game.TOI)
+		weightedPowerPlay += weight * float64(game.PowerPlayPoints)
+		for _, stat := range GameLogStats(game) {
+			value, _ := strconv.ParseFloat(stat.Value, 64)
+			rates[stat.StatID] += weight * value
+		}
+	}
+	for statID := range rates {
+		rates[statID] /= totalWeight
+	}
+	weightedTOI /= totalWeight
+	weightedPowerPlay /= totalWeight
+
+	recent := sorted
+	if len(recent) > usageRecentGames {
+		recent = recent[len(recent)-usageRecentGames:]
+	}
+	recentTOI := 0.0
+	recentPowerPlay := 0.0
+	for _, game := range recent {
+		recentTOI += parseTOI(game.TOI)
+		recentPowerPlay += float64(game.PowerPlayPoints)
+	}
+	recentTOI /= float64(len(recent))
+	recentPowerPlay /= float64(len(recent))
+
+	projection.AverageTOI = utils.RoundFloat(weightedTOI, 2)
+	projection.RecentTOI = utils.RoundFloat(recentTOI, 2)
+	projection.TOIFactor = utils.RoundFloat(usageFactor(recentTOI, weightedTOI), 3)
+	projection.PowerPlayFactor = utils.RoundFloat(usageFactor(recentPowerPlay, weightedPowerPlay), 3)
+
+	modifiers := make(map[string]float64)
+	for _, modifier := range statModifiers {
+		modifiers[modifier.StatID] = modifier.Value
+	}
+
+	statNames := utils.GetStatIDToNameMap()
+	statIDs := make([]string, 0, len(rates))
+	for statID := range rates {
+		statIDs = append(statIDs, statID)
+	}
+	sort.Slice(statIDs, func(i, j int) bool {
+		a, _ := strconv.Atoi(statIDs[i])
+		b, _ := strconv.Atoi(statIDs[j])
+		return a < b
+	})
+
+	pointsPerGame := 0.0
+	for _, statID := range statIDs {
+		perGame := rates[statID]
+		if toiScaledStats[statID] {
+			perGame *= projection.TOIFactor
+		} else if powerPlayScaledStats[statID] {
+			perGame *= projection.PowerPlayFactor
+		}
+		pointsPerGame += perGame * modifiers[statID]
+
+		projection.Stats = append(projection.Stats, models.ProjectedStat{
+			StatID:       statID,
+			Name:         statNames[statID],
+			PerGame:      utils.RoundFloat(perGame, 3),
+			RestOfSeason: utils.RoundFloat(perGame*float64(remainingGames), 1),
+		})
+	}
+
+	projection.PointsPerGame = utils.RoundFloat(pointsPerGame, 3)
+	projection.RestOfSeasonPoints = utils.RoundFloat(pointsPerGame*float64(remainingGames), 2)
+	return projection
+}
+
+// ApplyProjectionBaseline shrinks a projection's per-game rates toward the player's regular season
+// averages from prior seasons. The prior games weigh like at most baselineFullGames current games,
+// so the baseline matters early in a season and fades as the current sample grows.
+func ApplyProjectionBaseline(statModifiers []models.StatModifier, projection *models.PlayerProjection, prior []*models.PlayerGameStat) {
+	if len(prior) == 0 {
+		return
+	}
+
+	baseline := make(map[string]float64)
+	for _, game := range prior {
+		for _, stat := range GameLogStats(game) {
+			value, _ := strconv.ParseFloat(stat.Value, 64)
+			baseline[stat.StatID] += value / float64(len(prior))
+		}
+	}
+
+	priorWeight := math.Min(float64(len(prior)), baselineFullGames)
+	weight := priorWeight / (priorWeight + float64(projection.GamesSampled))
+	projection.BaselineGames = len(prior)
+	projection.BaselineWeight = utils.RoundFloat(weight, 3)
+
+	modifiers := make(map[string]float64)
+	for _, modifier := range statModifiers {
+		modifiers[modifier.StatID] = modifier.Value
+	}
+	statNames := utils.GetStatIDToNameMap()
+
+	current := make(map[string]float64)
+	for _, stat := range projection.Stats {
+		current[stat.StatID] = stat.PerGame
+	}
+	for statID := range baseline {
+		if _, ok := current[statID]; !ok {
+			current[statID] = 0
+		}
+	}
+
+	statIDs := make([]string, 0, len(current))
+	for statID := range current {
+		statIDs = append(statIDs, statID)
+	}
+	sort.Slice(statIDs, func(i, j int) bool {
+		a, _ := strconv.Atoi(statIDs[i])
+		b, _ := strconv.Atoi(statIDs[j])
+		return a < b
+	})
+
+	pointsPerGame := 0.0
+	projection.Stats = make([]models.ProjectedStat, 0, len(statIDs))
+	for _, statID := range statIDs {
+		perGame := (1-weight)*current[statID] + weight*baseline[statID]
+		pointsPerGame += perGame * modifiers[statID]
+
+		projection.Stats = append(projection.Stats, models.ProjectedStat{
+			StatID:       statID,
+			Name:         statNames[statID],
+			PerGame:      utils.RoundFloat(perGame, 3),
+			RestOfSeason: utils.RoundFloat(perGame*float64(projection.RemainingGames), 1),
+		})
+	}
+
+	projection.PointsPerGame = utils.RoundFloat(pointsPerGame, 3)
+	projection.RestOfSeasonPoints = utils.RoundFloat(pointsPerGame*float64(projection.RemainingGames), 2)
+}
+
+// BacktestPlayer projects from the games before asOf over the games actually played after it,
+// so the comparison measures the per-game rate rather than the schedule
+func BacktestPlayer(statModifiers []models.StatModifier, games []*models.PlayerGameStat, asOf string) (models.PlayerBacktest, bool) {
+	var before, after []*models.PlayerGameStat
+	for _, game := range games {
+		if game.GameDate < asOf {
+			before = append(before, game)
+		} else {
+			after = append(after, game)
+		}
+	}
+
+	if len(before) < minBacktestGames || len(after) == 0 {
+		return models.PlayerBacktest{}, false
+	}
+
+	projection := BuildPlayerProjection(statModifiers, before, len(after))
+
+	actual := 0.0
+	for _, game := range after {
+		actual += GameFantasyPoints(statModifiers, game)
+	}
+
+	return models.PlayerBacktest{
+		GamesBefore:     len(before),
+		GamesAfter:      len(after),
+		ProjectedPoints: projection.RestOfSeasonPoints,
+		ActualPoints:    utils.RoundFloat(actual, 2),
+		Error:           utils.RoundFloat(projection.RestOfSeasonPoints-actual, 2),
+	}, true
+}
+
+// SummarizeBacktest aggregates per-player backtest errors
+func SummarizeBacktest(asOf string, results []models.PlayerBacktest) *models.ProjectionBacktest {
+	summary := &models.ProjectionBacktest{
+		AsOf:    asOf,
+		Players: len(results),
+		Results: results,
+	}
+	if summary.Results == nil {
+		summary.Results = []models.PlayerBacktest{}
+	}
+	if len(results) == 0 {
+		return summary
+	}
+
+	absolute := 0.0
+	signed := 0.0
+	perGame := 0.0
+	for _, result := range results {
+		absolute += math.Abs(result.Error)
+		signed += result.Error
+		perGame += math.Abs(result.Error) / float64(result.GamesAfter)
+	}
+
+	count := float64(len(results))
+	summary.MeanAbsoluteError = utils.RoundFloat(absolute/count, 2)
+	summary.MeanError = utils.RoundFloat(signed/count, 2)
+	summary.PerGameAbsoluteError = utils.RoundFloat(perGame/count, 3)
+
+	sort.SliceStable(summary.Results, func(i, j int) bool {
+		return math.Abs(summary.Results[i].Error) > math.Abs(summary.Results[j].Error)
+	})
+
+	return summary
+}
+
+// usageFactor compares recent usage with the weighted average, damped and clamped
+func usageFactor(recent, average float64) float64 {
+	if average <= 0 {
+		return 1
+	}
+	factor := 1 + usageDampening*(recent/average-1)
+	return math.Max(usageFactorMin, math.Min(usageFactorMax, factor))
+}
+
+// parseTOI converts "MM:SS" ice time to minutes
+func parseTOI(toi string) float64 {
+	parts := strings.Split(toi, ":")
+	if len(parts) != 2 {
+		return 0
+	}
+	minutes, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0
+	}
+	seconds, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0
+	}
+	return float64(minutes) + float64(seconds)/60
+}
+
+func latestTeam(games []*models.PlayerGameStat) string {
+	latest := games[0]
+	for _, game := range games {
+		if game.GameDate >= latest.GameDate {
+			latest = game
+		}
+	}
+	return latest.TeamAbbrev
+}
+
+// teamGamesRemaining counts an NHL team's scheduled games from today through the league's end
+func teamGamesRemaining(teamAbbrev string, leagueEnd time.Time) (int, error) {
+	today := time.Now().Format(lineupDateLayout)
+	if leagueEnd.IsZero() || leagueEnd.Format(lineupDateLayout) < today {
+		return 0, nil
+	}
+
+	games, err := repositories.GetScheduleGamesBetween(today, leagueEnd.Format(lineupDateLayout))
+	if err != nil {
+		return 0, err
+	}
+
+	return gamesPerTeam(games)[teamAbbrev], nil
+}
+
+// currentSeasonStart is the first day a current season game can be played
+func currentSeasonStart() string {
+	return utils.GetCurrentNhlSeason()[:4] + "-07-01"
+}
+
+// gameLogRefreshes remembers the team game date each NHL player's log was last fetched through, so
+// a player who sat out is not refetched until their team plays again
+var gameLogRefreshes = struct {
+	sync.Mutex
+	through map[string]string
+}{through: make(map[string]string)}
+
+// lastCompletedGameDates returns the date of each NHL team's latest finished regular season game
+func lastCompletedGameDates() (map[string]string, error) {
+	today := time.Now().Format(lineupDateLayout)
+	games, err := repositories.GetScheduleGamesBetween(currentSeasonStart(), today)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[string]string)
+	for _, game := range games {
+		if game.GameType != models.RegularSeasonGame || !isFinalGameState(game.GameState) {
+			continue
+		}
+		for _, team := range []string{game.HomeTeamAbbrev, game.AwayTeamAbbrev} {
+			if game.GameDate > dates[team] {
+				dates[team] = game.GameDate
+			}
+		}
+	}
+	return dates, nil
+}
+
+// GameLogStale reports whether a current season log misses games completed since its latest game
+// by the player's team. An empty log is stale.
+func GameLogStale(games []*models.PlayerGameStat, lastCompleted map[string]string) bool {
+	if len(games) == 0 {
+		return true
+	}
+	latest := games[0]
+	for _, game := range games {
+		if game.GameDate > latest.GameDate {
+			latest = game
+		}
+	}
+	return lastCompleted[latest.TeamAbbrev] > latest.GameDate
+}
+
+// currentSeasonGameLog returns a player's stored current regular season games, fetching the season
+// from the NHL when the stored log is stale
+func currentSeasonGameLog(nhlId string, stored []*models.PlayerGameStat, lastCompleted map[string]string) ([]*models.PlayerGameStat, error) {
+	games := currentSeasonGames(stored)
+	if !GameLogStale(games, lastCompleted) {
+		return games, nil
+	}
+
+	through := ""
+	if len(games) > 0 {
+		through = lastCompleted[latestTeam(games)]
+	} else {
+		for _, date := range lastCompleted {
+			if date > through {
+				through = date
+			}
+		}
+	}
+
+	gameLogRefreshes.Lock()
+	fetched, ok := gameLogRefreshes.through[nhlId]
+	gameLogRefreshes.Unlock()
+	if ok && fetched == through {
+		return games, nil
+	}
+
+	refreshed, err := GetPlayerGameStatsNHL(nhlId, utils.GetCurrentNhlSeason(), models.RegularSeasonGame)
+	if err != nil {
+		return nil, err
+	}
+
+	gameLogRefreshes.Lock()
+	gameLogRefreshes.through[nhlId] = through
+	gameLogRefreshes.Unlock()
+	return currentSeasonGames(refreshed), nil
+}
+
+// currentSeasonGames keeps the current season's regular season games
+func currentSeasonGames(games []*models.PlayerGameStat) []*models.PlayerGameStat {
+	start := currentSeasonStart()
+	var current []*models.PlayerGameStat
+	for _, game := range games {
+		if game.GameDate >= start && gameLogType(game) == models.RegularSeasonGame {
+			current = append(current, game)
+		}
+	}
+	return current
+}
+
+// seasonGames keeps one season's regular season games
+func seasonGames(games []*models.PlayerGameStat, season int) []*models.PlayerGameStat {
+	var kept []*models.PlayerGameStat
+	for _, game := range games {
+		if gameSeason(game) == season && gameLogType(game) == models.RegularSeasonGame {
+			kept = append(kept, game)
+		}
+	}
+	return kept
+}
+
+// priorSeasonGames keeps the regular season games of the given number of seasons before the current one
+func priorSeasonGames(games []*models.PlayerGameStat, seasons int) []*models.PlayerGameStat {
+	wanted := make(map[int]bool)
+	for _, season := range utils.PreviousNhlSeasons(utils.GetCurrentNhlSeason(), seasons) {
+		id, _ := strconv.Atoi(season)
+		wanted[id] = true
+	}
+
+	var prior []*models.PlayerGameStat
+	for _, game := range games {
+		if wanted[gameSeason(game)] && gameLogType(game) == models.RegularSeasonGame {
+			prior = append(prior, game)
+		}
+	}
+	return prior
+}
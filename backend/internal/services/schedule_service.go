@@ -0,0 +1,248 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	lightNightMaxGames = 7  // Fewer than half the league plays
+	heavyNightMinGames = 12 // Three quarters of the league plays
+)
+
+// FantasyWeekDates returns the first and last day of a fantasy week from Yahoo's game weeks
+func FantasyWeekDates(weeks []models.GameWeek, week int) (time.Time, time.Time, error) {
+	for _, gameWeek := range weeks {
+		if gameWeek.Week == week {
+			return gameWeek.Start, gameWeek.End, nil
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("week %d is not part of the season", week)
+}
+
+// GetLeagueWeekSchedule reports NHL games per team and per night for a fantasy week, the current
+// week when week is empty.
+func GetLeagueWeekSchedule(sessionId, leagueId, week string) (*models.WeekSchedule, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	weekNumber, start, end, err := leagueWeekDates(sessionId, settings, week)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := repositories.GetScheduleGamesBetween(start.Format(lineupDateLayout), end.Format(lineupDateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := BuildWeekSchedule(weekNumber, start, end, games)
+	return &schedule, nil
+}
+
+// GetTeamGamesRemaining counts the games a fantasy team's players have left in a week and how many
+// of them fit in the league's starting slots.
+func GetTeamGamesRemaining(sessionId, teamId, week string) (*models.TeamGamesRemaining, error) {
+	leagueId, err := utils.TeamtoLeagueId(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	weekNumber, start, end, err := leagueWeekDates(sessionId, settings, week)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err := GetFantasyTeamRoster(sessionId, teamId, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	from := start
+	today, _ := time.Parse(lineupDateLayout, time.Now().Format(lineupDateLayout))
+	if today.After(from) {
+		from = today
+	}
+
+	var games []models.ScheduleGame
+	if !from.After(end) {
+		games, err = repositories.GetScheduleGamesBetween(from.Format(lineupDateLayout), end.Format(lineupDateLayout))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	remaining := BuildTeamGamesRemaining(teamId, settings.RosterPositions, roster.Players, from, end, games)
+	remaining.Week = weekNumber
+	remaining.StartDate = start.Format(lineupDateLayout)
+	remaining.EndDate = end.Format(lineupDateLayout)
+	return remaining, nil
+}
+
+func leagueWeekDates(sessionId string, settings *models.League, week string) (int, time.Time, time.Time, error) {
+	weekNumber := settings.CurrentWeek
+	if week != "" {
+		parsed, err := strconv.Atoi(week)
+		if err != nil {
+			return 0, time.Time{}, time.Time{}, fmt.Errorf("invalid week %s: %w", week, err)
+		}
+		weekNumber = parsed
+	}
+
+	weeks, err := GetGameWeeks(sessionId, settings.LeagueKey)
+	if err != nil {
+		return 0, time.Time{}, time.Time{}, err
+	}
+
+	start, end, err := FantasyWeekDates(weeks, weekNumber)
+	if err != nil {
+		return 0, time.Time{}, time.Time{}, utils.NewBadRequestError(err.Error())
+	}
+
+	return weekNumber, start, end, nil
+}
+
+// BuildWeekSchedule counts games per night and per NHL team between two dates
+func BuildWeekSchedule(week int, start, end time.Time, games []models.ScheduleGame) models.WeekSchedule {
+	schedule := models.WeekSchedule{
+		Week:      week,
+		StartDate: start.Format(lineupDateLayout),
+		EndDate:   end.Format(lineupDateLayout),
+		Nights:    []models.ScheduleNight{},
+		Teams:     []models.NHLTeamWeekSchedule{},
+	}
+
+	gamesPerNight := make(map[string]int)
+	teamDates := make(map[string][]string)
+	for _, game := range games {
+		gamesPerNight[game.GameDate]++
+		teamDates[game.HomeTeamAbbrev] = append(teamDates[game.HomeTeamAbbrev], game.GameDate)
+		teamDates[game.AwayTeamAbbrev] = append(teamDates[game.AwayTeamAbbrev], game.GameDate)
+	}
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(lineupDateLayout)
+		count := gamesPerNight[date]
+		schedule.Nights = append(schedule.Nights, models.ScheduleNight{
+			Date:  date,
+			Games: count,
+			Light: count > 0 && count <= lightNightMaxGames,
+			Heavy: count >= heavyNightMinGames,
+		})
+	}
+
+	for abbr := range utils.GetNHLTeamAbbreviations() {
+		dates := teamDates[abbr]
+		sort.Strings(dates)
+
+		team := models.NHLTeamWeekSchedule{
+			Team:      abbr,
+			Games:     len(dates),
+			GameDates: dates,
+		}
+		if team.GameDates == nil {
+			team.GameDates = []string{}
+		}
+
+		for i, date := range dates {
+			if gamesPerNight[date] <= lightNightMaxGames {
+				team.LightNightGames++
+			}
+			if i > 0 {
+				previous, _ := time.Parse(lineupDateLayout, dates[i-1])
+				current, _ := time.Parse(lineupDateLayout, date)
+				if current.Sub(previous) == 24*time.Hour {
+					team.BackToBacks++
+				}
+			}
+		}
+
+		schedule.Teams = append(schedule.Teams, team)
+	}
+
+	sort.Slice(schedule.Teams, func(i, j int) bool {
+		if schedule.Teams[i].Games != schedule.Teams[j].Games {
+			return schedule.Teams[i].Games > schedule.Teams[j].Games
+		}
+		if schedule.Teams[i].LightNightGames != schedule.Teams[j].LightNightGames {
+			return schedule.Teams[i].LightNightGames > schedule.Teams[j].LightNightGames
+		}
+		return schedule.Teams[i].Team < schedule.Teams[j].Team
+	})
+
+	return schedule
+}
+
+// BuildTeamGamesRemaining counts a roster's games per day between two dates. Usable games come from
+// the lineup optimizer with every game worth the same, so multi-position players are placed legally.
+func BuildTeamGamesRemaining(teamId string, positions []models.RosterPosition, players []models.RosterPlayer, from, end time.Time, games []models.ScheduleGame) *models.TeamGamesRemaining {
+	remaining := &models.TeamGamesRemaining{
+		TeamKey:  teamId,
+		FromDate: from.Format(lineupDateLayout),
+		Days:     []models.TeamGameDay{},
+		Players:  []models.PlayerGamesRemaining{},
+	}
+
+	opponents := opponentsByDate(games)
+	unitPoints := make(map[string]float64)
+	playerGames := make(map[string]int)
+	for _, player := range players {
+		unitPoints[player.PlayerKey] = 1
+	}
+
+	for day := from; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(lineupDateLayout)
+		lineup := OptimizeLineupForDay(date, positions, players, unitPoints, opponents[date])
+
+		gameDay := models.TeamGameDay{Date: date}
+		for _, slot := range lineup.Starters {
+			if slot.PlayerKey == "" {
+				gameDay.OpenSlots++
+			} else {
+				gameDay.Usable++
+			}
+		}
+		for _, player := range players {
+			if _, ok := opponents[date][utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)]; ok && !isReserveSlot(player.SelectedPosition) && !utils.IsRuledOut(player.Status) {
+				gameDay.Games++
+				playerGames[player.PlayerKey]++
+			}
+		}
+
+		remaining.Games += gameDay.Games
+		remaining.UsableGames += gameDay.Usable
+		remaining.OpenSlots += gameDay.OpenSlots
+		remaining.Days = append(remaining.Days, gameDay)
+	}
+	remaining.WastedGames = remaining.Games - remaining.UsableGames
+
+	for _, player := range players {
+		if isReserveSlot(player.SelectedPosition) {
+			continue
+		}
+		remaining.Players = append(remaining.Players, models.PlayerGamesRemaining{
+			PlayerKey: player.PlayerKey,
+			Name:      player.Name,
+			Team:      player.TeamAbbreviation,
+			Games:     playerGames[player.PlayerKey],
+		})
+	}
+	sort.SliceStable(remaining.Players, func(i, j int) bool {
+		return remaining.Players[i].Games > remaining.Players[j].Games
+	})
+
+	return remaining
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const defaultNHLLiveURL = "https://api-web.nhle.com/v1"
+
+// nhlLiveURL is the base for scoreboard and boxscore requests. NHL_LIVE_URL points it at a local
+// stand-in serving the same JSON.
+func nhlLiveURL() string {
+	if url := os.Getenv("NHL_LIVE_URL"); url != "" {
+		return strings.TrimRight(url, "/")
+	}
+	return defaultNHLLiveURL
+}
+
+// SyncScoreboard fetches the NHL scoreboard for a date and stores each game's state
+func SyncScoreboard(date string) ([]*models.LiveGame, error) {
+	response, err := GetHttpRequest(fmt.Sprintf("%s/score/%s", nhlLiveURL(), date))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scoreboard for %s: %w", date, err)
+	}
+
+	now := time.Now()
+	games := []*models.LiveGame{}
+	gamesData, _ := response["games"].([]interface{})
+	for _, gameData := range gamesData {
+		gameMap, ok := gameData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		game, err := MapScoreboardGame(gameMap)
+		if err != nil {
+			return nil, err
+		}
+		if game.GameDate == "" {
+			game.GameDate = date
+		}
+		game.UpdatedAt = now
+		games = append(games, game)
+	}
+
+	if err := repositories.SaveGames(games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// GetScoreboard returns the stored games for a date, syncing first unless every game is over
+func GetScoreboard(date string) ([]*models.LiveGame, error) {
+	games, err := repositories.GetGamesOnDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, game := range games {
+		if game.Status != models.GameStatusFinal && game.Status != models.GameStatusPostponed {
+			return SyncScoreboard(date)
+		}
+	}
+	if len(games) == 0 {
+		return SyncScoreboard(date)
+	}
+	return games, nil
+}
+
+// GetGameBoxscore fetches the live stat line of every player in a game
+func GetGameBoxscore(gameId int64) (map[string]*models.PlayerGameStat, error) {
+	response, err := GetHttpRequest(fmt.Sprintf("%s/gamecenter/%d/boxscore", nhlLiveURL(), gameId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch boxscore for game %d: %w", gameId, err)
+	}
+	return MapBoxscorePlayers(response), nil
+}
+
+// GetTeamToday lists a fantasy team's rostered players with a game on the date and their live lines
+func GetTeamToday(sessionId, teamId, date string) (*models.TeamToday, error) {
+	leagueId, err := utils.TeamtoLeagueId(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err := GetFantasyTeamRoster(sessionId, teamId, "", date)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := GetScoreboard(date)
+	if err != nil {
+		return nil, err
+	}
+	byTeam := gamesByTeam(games)
+
+	playerKeys := make([]string, 0, len(roster.Players))
+	for _, player := range roster.Players {
+		playerKeys = append(playerKeys, player.PlayerKey)
+	}
+	nhlIds, err := nhlPlayerIDs(playerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(map[string]*models.PlayerGameStat)
+	boxscores := make(map[int64]bool)
+	for _, player := range roster.Players {
+		game, ok := byTeam[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)]
+		if !ok {
+			continue
+		}
+		if _, ok := nhlIds[player.PlayerKey]; !ok {
+			log.Printf("No NHL player mapped to %s, so their live stats are skipped", player.PlayerKey)
+			continue
+		}
+
+		if game.Status == models.GameStatusScheduled || game.Status == models.GameStatusPostponed || boxscores[game.ID] {
+			continue
+		}
+		boxscores[game.ID] = true
+
+		gameLines, err := GetGameBoxscore(game.ID)
+		if err != nil {
+			log.Printf("Failed to get boxscore for game %d: %v", game.ID, err)
+			continue
+		}
+		for playerId, line := range gameLines {
+			lines[playerId] = line
+		}
+	}
+
+	today := BuildTeamToday(settings.StatModifiers, roster.Players, games, nhlIds, lines)
+	today.TeamKey = roster.TeamKey
+	today.Date = date
+	return &today, nil
+}
+
+// BuildTeamToday pairs rostered players with their team's game on the date and scores the live
+// stat lines, keyed by NHL player id, with the league's modifiers
+func BuildTeamToday(statModifiers []models.StatModifier, players []models.RosterPlayer, games []*models.LiveGame, nhlIds map[string]string, lines map[string]*models.PlayerGameStat) models.TeamToday {
+	today := models.TeamToday{
+		Playing:    []models.TodayPlayer{},
+		NotPlaying: []string{},
+	}
+	byTeam := gamesByTeam(games)
+
+	for _, player := range players {
+		team := utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)
+		game, ok := byTeam[team]
+		if !ok || game.Status == models.GameStatusPostponed {
+			today.NotPlaying = append(today.NotPlaying, player.Name)
+			continue
+		}
+
+		entry := models.TodayPlayer{
+			PlayerKey:        player.PlayerKey,
+			NHLPlayerID:      nhlIds[player.PlayerKey],
+			Name:             player.Name,
+			Team:             team,
+			SelectedPosition: player.SelectedPosition,
+			IsStarting:       player.IsStarting,
+			GameID:           game.ID,
+			Status:           game.Status,
+			Period:           game.Period,
+			Clock:            game.Clock,
+			StartTimeUTC:     game.StartTimeUTC,
+		}
+		if game.HomeTeamAbbrev == team {
+			entry.Opponent = "vs " + game.AwayTeamAbbrev
+			entry.Score = strconv.Itoa(game.HomeScore) + "-" + strconv.Itoa(game.AwayScore)
+		} else {
+			entry.Opponent = "@ " + game.HomeTeamAbbrev
+			entry.Score = strconv.Itoa(game.AwayScore) + "-" + strconv.Itoa(game.HomeScore)
+		}
+
+		if line, ok := lines[entry.NHLPlayerID]; ok && entry.NHLPlayerID != "" {
+			entry.StatLine = line
+			entry.FantasyPoints = utils.RoundFloat(GameFantasyPoints(statModifiers, line), 2)
+		}
+
+		if player.IsStarting {
+			today.StarterPoints += entry.FantasyPoints
+			switch game.Status {
+			case models.GameStatusLive:
+				today.StartersLive++
+			case models.GameStatusScheduled:
+				today.StartersLeft++
+			}
+		}
+
+		today.Playing = append(today.Playing, entry)
+	}
+
+	today.StarterPoints = utils.RoundFloat(today.StarterPoints, 2)
+	return today
+}
+
+// GameStatus collapses the NHL game and schedule states into scheduled, live, final or postponed
+func GameStatus(gameState, scheduleState string) string {
+	if scheduleState == "PPD" || scheduleState == "CNCL" || scheduleState == "SUSP" {
+		return models.GameStatusPostponed
+	}
+
+	switch gameState {
+	case "LIVE", "CRIT":
+		return models.GameStatusLive
+	case "FINAL", "OFF":
+		return models.GameStatusFinal
+	}
+	return models.GameStatusScheduled
+}
+
+func gamesByTeam(games []*models.LiveGame) map[string]*models.LiveGame {
+	byTeam := make(map[string]*models.LiveGame)
+	for _, game := range games {
+		byTeam[game.HomeTeamAbbrev] = game
+		byTeam[game.AwayTeamAbbrev] = game
+	}
+	return byTeam
+}
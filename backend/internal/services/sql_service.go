@@ -30,6 +30,13 @@ func ConnectToMySQL() error {
 
 	repositories.Init(DB)
 
+	// The schema is managed outside the app; opt in to creating the tables and columns it adds
+	if os.Getenv("SQL_AUTO_MIGRATE") == "true" {
+		if err := repositories.Migrate(); err != nil {
+			return fmt.Errorf("failed to migrate mysql schema: %w", err)
+		}
+	}
+
 	log.Println("Connected to MySQL successfully")
 	return nil
 }
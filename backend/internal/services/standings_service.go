@@ -0,0 +1,412 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func GetLeagueStandings(sessionId, leagueId string) (*models.LeagueStandingsResponse, error) {
+	standings, err := syncLeagueStandings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SyncLeagueMatchups(sessionId, leagueId); err != nil {
+		return nil, err
+	}
+
+	matchups, err := repositories.GetLeagueMatchups(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	weeklyPoints, err := repositories.GetLeagueTeamWeeklyPoints(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	teamNames := make(map[string]string)
+	week := 0
+	for _, standing := range standings {
+		teamNames[standing.TeamKey] = standing.Name
+		week = standing.Week
+	}
+
+	return &models.LeagueStandingsResponse{
+		LeagueID:      leagueId,
+		Week:          week,
+		Standings:     standings,
+		PowerRankings: ComputePowerRankings(teamNames, matchups, weeklyPoints),
+	}, nil
+}
+
+// syncLeagueStandings fetches a league's current standings from Yahoo and stores them
+func syncLeagueStandings(sessionId, leagueId string) ([]models.TeamStanding, error) {
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/standings", leagueId)
+	standingsResponse, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch standings for league: %w", err)
+	}
+
+	standings, err := MapLeagueStandings(standingsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map standings for league: %w", err)
+	}
+
+	if err := repositories.SaveTeamStandings(standings); err != nil {
+		return nil, err
+	}
+
+	return standings, nil
+}
+
+// SyncLeagueMatchups stores every completed week of the league that has no stored matchups yet,
+// fetching one league scoreboard per week
+func SyncLeagueMatchups(sessionId, leagueId string) error {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return err
+	}
+
+	stored, err := repositories.GetLeagueMatchups(leagueId)
+	if err != nil {
+		return err
+	}
+
+	for _, week := range UnsyncedMatchupWeeks(settings, stored, time.Now()) {
+		url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/scoreboard;week=%d", leagueId, week)
+		response, err := AuthHttpXMLRequest(sessionId, url)
+		if err != nil {
+			return fmt.Errorf("failed to fetch week %d scoreboard: %w", week, err)
+		}
+
+		matchups, teamStats, statWinners, err := MapLeagueScoreboard(response)
+		if err != nil {
+			return fmt.Errorf("failed to map week %d scoreboard: %w", week, err)
+		}
+
+		if err := repositories.SaveTeamMatchups(matchups, teamStats, statWinners); err != nil {
+			return fmt.Errorf("failed to save week %d matchups: %w", week, err)
+		}
+	}
+
+	return nil
+}
+
+// UnsyncedMatchupWeeks lists the completed weeks of a league with no stored matchups.
+// The current week only counts once the league's end date has passed.
+func UnsyncedMatchupWeeks(settings *models.League, stored []*models.Matchup, now time.Time) []int {
+	lastWeek := settings.CurrentWeek - 1
+	if !settings.EndDate.IsZero() && now.After(settings.EndDate.AddDate(0, 0, 1)) {
+		lastWeek = settings.EndWeek
+	}
+
+	synced := make(map[string]bool)
+	for _, matchup := range stored {
+		synced[matchup.Week] = true
+	}
+
+	var weeks []int
+	for week := settings.StartWeek; week <= lastWeek; week++ {
+		if !synced[strconv.Itoa(week)] {
+			weeks = append(weeks, week)
+		}
+	}
+	return weeks
+}
+
+type allPlayRecord struct {
+	Wins   int
+	Losses int
+	Ties   int
+}
+
+func (r allPlayRecord) percentage() float64 {
+	games := r.Wins + r.Losses + r.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + 0.5*float64(r.Ties)) / float64(games)
+}
+
+// groupWeeklyPoints indexes weekly points as week -> team key -> points
+func groupWeeklyPoints(weeklyPoints []models.TeamWeeklyStats) map[string]map[string]float64 {
+	scores := make(map[string]map[string]float64)
+	for _, stats := range weeklyPoints {
+		if scores[stats.Week] == nil {
+			scores[stats.Week] = make(map[string]float64)
+		}
+		scores[stats.Week][stats.TeamKey] = stats.Points
+	}
+	return scores
+}
+
+// allPlayForWeek compares a team's score with every other team's score in the same week
+func allPlayForWeek(weekScores map[string]float64, teamKey string) allPlayRecord {
+	var record allPlayRecord
+	points := weekScores[teamKey]
+	for otherKey, otherPoints := range weekScores {
+		if otherKey == teamKey {
+			continue
+		}
+		switch {
+		case points > otherPoints:
+			record.Wins++
+		case points < otherPoints:
+			record.Losses++
+		default:
+			record.Ties++
+		}
+	}
+	return record
+}
+
+// ComputePowerRankings ranks teams from stored matchups and weekly points.
+// The power score weights all-play percentage at 50%, actual win percentage at 25%
+// and points per game relative to the league's best at 25%, scaled to 100. Ties count as half a win.
+func ComputePowerRankings(teamNames map[string]string, matchups []*models.Matchup, weeklyPoints []models.TeamWeeklyStats) []models.PowerRanking {
+	scores := groupWeeklyPoints(weeklyPoints)
+
+	rankings := make(map[string]*models.PowerRanking)
+	getRanking := func(teamKey string) *models.PowerRanking {
+		if ranking, ok := rankings[teamKey]; ok {
+			return ranking
+		}
+		ranking := &models.PowerRanking{TeamKey: teamKey, Name: teamNames[teamKey]}
+		rankings[teamKey] = ranking
+		return ranking
+	}
+	for teamKey := range teamNames {
+		getRanking(teamKey)
+	}
+
+	// All-play records across every scored week
+	allPlay := make(map[string]allPlayRecord)
+	for _, weekScores := range scores {
+		for teamKey := range weekScores {
+			weekRecord := allPlayForWeek(weekScores, teamKey)
+			record := allPlay[teamKey]
+			record.Wins += weekRecord.Wins
+			record.Losses += weekRecord.Losses
+			record.Ties += weekRecord.Ties
+			allPlay[teamKey] = record
+			getRanking(teamKey)
+		}
+	}
+
+	// Actual results, points for/against and opponents faced
+	opponents := make(map[string][]string)
+	for _, matchup := range matchups {
+		winner := getRanking(matchup.WinningTeam)
+		loser := getRanking(matchup.LosingTeam)
+		if matchup.IsTied {
+			winner.Ties++
+			loser.Ties++
+		} else {
+			winner.Wins++
+			loser.Losses++
+		}
+
+		winnerPoints := scores[matchup.Week][matchup.WinningTeam]
+		loserPoints := scores[matchup.Week][matchup.LosingTeam]
+		winner.PointsFor += winnerPoints
+		winner.PointsAgainst += loserPoints
+		loser.PointsFor += loserPoints
+		loser.PointsAgainst += winnerPoints
+
+		opponents[matchup.WinningTeam] = append(opponents[matchup.WinningTeam], matchup.LosingTeam)
+		opponents[matchup.LosingTeam] = append(opponents[matchup.LosingTeam], matchup.WinningTeam)
+	}
+
+	bestPointsPerGame := 0.0
+	for teamKey, ranking := range rankings {
+		if games := ranking.Wins + ranking.Losses + ranking.Ties; games > 0 {
+			if perGame := ranking.PointsFor / float64(games); perGame > bestPointsPerGame {
+				bestPointsPerGame = perGame
+			}
+		}
+
+		record := allPlay[teamKey]
+		ranking.AllPlayWins = record.Wins
+		ranking.AllPlayLosses = record.Losses
+		ranking.AllPlayTies = record.Ties
+		ranking.AllPlayPercentage = record.percentage()
+	}
+
+	var result []models.PowerRanking
+	for teamKey, ranking := range rankings {
+		games := ranking.Wins + ranking.Losses + ranking.Ties
+
+		if len(opponents[teamKey]) > 0 {
+			total := 0.0
+			for _, opponent := range opponents[teamKey] {
+				total += allPlay[opponent].percentage()
+			}
+			ranking.StrengthOfSchedule = utils.RoundFloat(total/float64(len(opponents[teamKey])), 3)
+		}
+
+		winPercentage, pointsIndex := 0.0, 0.0
+		if games > 0 {
+			winPercentage = (float64(ranking.Wins) + 0.5*float64(ranking.Ties)) / float64(games)
+			if bestPointsPerGame > 0 {
+				pointsIndex = (ranking.PointsFor / float64(games)) / bestPointsPerGame
+			}
+		}
+
+		ranking.PowerScore = utils.RoundFloat(100*(0.5*ranking.AllPlayPercentage+0.25*winPercentage+0.25*pointsIndex), 2)
+		ranking.AllPlayPercentage = utils.RoundFloat(ranking.AllPlayPercentage, 3)
+		ranking.PointsFor = utils.RoundFloat(ranking.PointsFor, 2)
+		ranking.PointsAgainst = utils.RoundFloat(ranking.PointsAgainst, 2)
+		result = append(result, *ranking)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PowerScore != result[j].PowerScore {
+			return result[i].PowerScore > result[j].PowerScore
+		}
+		if result[i].PointsFor != result[j].PointsFor {
+			return result[i].PointsFor > result[j].PointsFor
+		}
+		return result[i].TeamKey < result[j].TeamKey
+	})
+
+	for i := range result {
+		result[i].Rank = i + 1
+	}
+
+	return result
+}
+
+func GetLeagueLuckAnalysis(sessionId, leagueId string) (*models.LeagueLuckResponse, error) {
+	teams, err := GetAllTeamsInLeague(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SyncLeagueMatchups(sessionId, leagueId); err != nil {
+		return nil, err
+	}
+
+	matchups, err := repositories.GetLeagueMatchups(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	weeklyPoints, err := repositories.GetLeagueTeamWeeklyPoints(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	teamNames := make(map[string]string)
+	for _, team := range teams {
+		teamNames[team.TeamId] = team.Name
+	}
+
+	return &models.LeagueLuckResponse{
+		LeagueID: leagueId,
+		Teams:    ComputeLuckAnalysis(teamNames, matchups, weeklyPoints),
+	}, nil
+}
+
+// ComputeLuckAnalysis compares each team's actual record with the record it would have
+// if it played every other team each week. Positive luck means more wins than the scores earned.
+// A tied matchup counts as half a win.
+func ComputeLuckAnalysis(teamNames map[string]string, matchups []*models.Matchup, weeklyPoints []models.TeamWeeklyStats) []models.TeamLuck {
+	scores := groupWeeklyPoints(weeklyPoints)
+
+	teams := make(map[string]*models.TeamLuck)
+	getTeam := func(teamKey string) *models.TeamLuck {
+		if team, ok := teams[teamKey]; ok {
+			return team
+		}
+		team := &models.TeamLuck{TeamKey: teamKey, Name: teamNames[teamKey]}
+		teams[teamKey] = team
+		return team
+	}
+	for teamKey := range teamNames {
+		getTeam(teamKey)
+	}
+
+	for _, matchup := range matchups {
+		weekScores, ok := scores[matchup.Week]
+		if !ok {
+			continue
+		}
+
+		week, err := strconv.Atoi(matchup.Week)
+		if err != nil {
+			continue
+		}
+
+		for _, side := range []struct {
+			teamKey  string
+			opponent string
+			won      bool
+		}{
+			{matchup.WinningTeam, matchup.LosingTeam, true},
+			{matchup.LosingTeam, matchup.WinningTeam, false},
+		} {
+			record := allPlayForWeek(weekScores, side.teamKey)
+			games := record.Wins + record.Losses + record.Ties
+
+			expected := 0.0
+			if games > 0 {
+				expected = record.percentage()
+			}
+
+			actual := 0.0
+			team := getTeam(side.teamKey)
+			switch {
+			case matchup.IsTied:
+				actual = 0.5
+				team.Ties++
+			case side.won:
+				actual = 1
+				team.Wins++
+			default:
+				team.Losses++
+			}
+			team.AllPlayWins += record.Wins
+			team.AllPlayLosses += record.Losses
+			team.AllPlayTies += record.Ties
+			team.ExpectedWins += expected
+			team.Weeks = append(team.Weeks, models.WeeklyLuck{
+				Week:          week,
+				Points:        weekScores[side.teamKey],
+				Opponent:      side.opponent,
+				Won:           side.won && !matchup.IsTied,
+				Tied:          matchup.IsTied,
+				AllPlayWins:   record.Wins,
+				AllPlayLosses: record.Losses,
+				AllPlayTies:   record.Ties,
+				ExpectedWins:  utils.RoundFloat(expected, 3),
+				Luck:          utils.RoundFloat(actual-expected, 3),
+			})
+		}
+	}
+
+	var result []models.TeamLuck
+	for _, team := range teams {
+		sort.Slice(team.Weeks, func(i, j int) bool {
+			return team.Weeks[i].Week < team.Weeks[j].Week
+		})
+		team.LuckIndex = utils.RoundFloat(float64(team.Wins)+0.5*float64(team.Ties)-team.ExpectedWins, 3)
+		team.ExpectedWins = utils.RoundFloat(team.ExpectedWins, 3)
+		result = append(result, *team)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].LuckIndex != result[j].LuckIndex {
+			return result[i].LuckIndex > result[j].LuckIndex
+		}
+		return result[i].TeamKey < result[j].TeamKey
+	})
+
+	return result
+}
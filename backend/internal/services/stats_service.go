@@ -2,9 +2,12 @@ package services
 
 import (
 	"fmt"
+	"log"
+	"sort"
 	"strconv"
 
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
 )
 
 func GetLeaguePlayerStats(statModifiers []models.StatModifier, player models.Player) (*models.Player, float64, error) {
@@ -41,7 +44,211 @@ func GetLeaguePlayerStats(statModifiers []models.StatModifier, player models.Pla
 	return &player, totalPoints, nil
 }
 
-func GetProjectedvsExpected(userSession, fTeamId string) ([]models.ProjectedVsActualStats, error) {
+// GameLogStats converts an NHL game log line to the Yahoo stat IDs it covers. Skater and goalie
+// stats are both returned since the other side's fields are zero.
+func GameLogStats(game *models.PlayerGameStat) []models.Stat {
+	wins := 0
+	if game.Decision == "W" {
+		wins = 1
+	}
+
+	values := map[string]int{
+		"1":  game.Goals,
+		"2":  game.Assists,
+		"3":  game.Points,
+		"4":  game.PlusMinus,
+		"5":  game.PIM,
+		"6":  game.PowerPlayGoals,
+		"7":  game.PowerPlayPoints - game.PowerPlayGoals,
+		"8":  game.PowerPlayPoints,
+		"9":  game.ShorthandedGoals,
+		"10": game.ShorthandedPoints - game.ShorthandedGoals,
+		"11": game.GameWinningGoals,
+		"12": game.OTGoals,
+		"14": game.Shots,
+		"19": wins,
+		"21": game.GamesStarted,
+		"22": game.GoalsAgainst,
+		"25": game.Saves,
+		"27": game.Shutouts,
+	}
+
+	stats := make([]models.Stat, 0, len(values)+1)
+	for statID, value := range values {
+		stats = append(stats, models.Stat{StatID: statID, Value: strconv.Itoa(value)})
+	}
+	if game.ShotsAgainst > 0 {
+		stats = append(stats, models.Stat{StatID: "28", Value: strconv.FormatFloat(game.SavePctg, 'f', 3, 64)})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		a, _ := strconv.Atoi(stats[i].StatID)
+		b, _ := strconv.Atoi(stats[j].StatID)
+		return a < b
+	})
+
+	return stats
+}
+
+// GameFantasyPoints scores an NHL game log line with the league's stat modifiers
+func GameFantasyPoints(statModifiers []models.StatModifier, game *models.PlayerGameStat) float64 {
+	_, points, err := GetLeaguePlayerStats(statModifiers, models.Player{Stats: GameLogStats(game)})
+	if err != nil {
+		return 0
+	}
+	return points
+}
+
+func GetProjectedVsActual(userSession, fTeamId string) (*models.ProjectedVsActualStats, error) {
+	leagueId, err := utils.TeamtoLeagueId(fTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	currentWeekStr, err := GetLeagueSetting(userSession, leagueId, "CurrentWeek")
+	if err != nil {
+		return nil, err
+	}
+
+	currentWeek, err := strconv.Atoi(currentWeekStr)
+	if err != nil {
+		return nil, err
+	}
+
+	weeklyStats, err := GetTeamWeeklyStats(userSession, fTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	rosters := make(map[int][]models.RosterPlayer)
+	for week := range weeklyStats {
+		// Completed weeks never change, so stored lineups are reused once they carry points
+		if week < currentWeek {
+			stored, err := GetStoredTeamRoster(fTeamId, "week", strconv.Itoa(week))
+			if err != nil {
+				log.Printf("Failed to read stored roster for week %d: %v", week, err)
+			}
+			if stored != nil && rosterHasPoints(stored.Players) {
+				rosters[week] = stored.Players
+				continue
+			}
+		}
+
+		roster, err := GetTeamRosterWeek(userSession, fTeamId, week)
+		if err != nil {
+			log.Printf("Failed to get player contributions for week %d: %v", week, err)
+			continue
+		}
+		rosters[week] = roster
+	}
+
+	return BuildProjectedVsActual(fTeamId, currentWeek, weeklyStats, rosters), nil
+}
+
+// BuildProjectedVsActual compares projected and final points per week. The current week is
+// reported but left out of the totals because its final points are still a live projection.
+func BuildProjectedVsActual(teamId string, currentWeek int, weeklyStats map[int]map[string]string, rosters map[int][]models.RosterPlayer) *models.ProjectedVsActualStats {
+	result := &models.ProjectedVsActualStats{
+		TeamKey:       teamId,
+		Weeks:         []models.ProjectedVsActualWeek{},
+		SeasonPlayers: []models.PlayerContribution{},
+	}
+
+	weeks := make([]int, 0, len(weeklyStats))
+	for week := range weeklyStats {
+		weeks = append(weeks, week)
+	}
+	sort.Ints(weeks)
+
+	seasonPlayers := make(map[string]*models.PlayerContribution)
+	completedWeeks := 0
+	cumulative := 0.0
+
+	for _, week := range weeks {
+		projected, _ := strconv.ParseFloat(weeklyStats[week]["expectedPoints"], 64)
+		actual, _ := strconv.ParseFloat(weeklyStats[week]["finalPoints"], 64)
+		delta := actual - projected
+		inProgress := week >= currentWeek
+
+		if !inProgress {
+			completedWeeks++
+			cumulative += delta
+			result.TotalProjected += projected
+			result.TotalActual += actual
+			if delta > 0 {
+				result.WeeksOver++
+			} else if delta < 0 {
+				result.WeeksUnder++
+			}
+		}
+
+		players := []models.PlayerContribution{}
+		for _, player := range rosters[week] {
+			if utils.IsBenchPosition(player.SelectedPosition) {
+				continue
+			}
+
+			share := 0.0
+			if actual != 0 {
+				share = player.Points / actual
+			}
+			players = append(players, models.PlayerContribution{
+				PlayerKey:        player.PlayerKey,
+				Name:             player.Name,
+				SelectedPosition: player.SelectedPosition,
+				Points:           utils.RoundFloat(player.Points, 2),
+				Share:            utils.RoundFloat(share, 3),
+			})
 
-	return nil, nil
+			if !inProgress {
+				total, ok := seasonPlayers[player.PlayerKey]
+				if !ok {
+					total = &models.PlayerContribution{PlayerKey: player.PlayerKey, Name: player.Name}
+					seasonPlayers[player.PlayerKey] = total
+				}
+				total.Points += player.Points
+			}
+		}
+		sort.Slice(players, func(i, j int) bool {
+			return players[i].Points > players[j].Points
+		})
+
+		result.Weeks = append(result.Weeks, models.ProjectedVsActualWeek{
+			Week:            week,
+			ProjectedPoints: projected,
+			ActualPoints:    actual,
+			Delta:           utils.RoundFloat(delta, 2),
+			CumulativeDelta: utils.RoundFloat(cumulative, 2),
+			InProgress:      inProgress,
+			Players:         players,
+		})
+	}
+
+	result.TotalDelta = utils.RoundFloat(result.TotalActual-result.TotalProjected, 2)
+	if completedWeeks > 0 {
+		result.AverageDelta = utils.RoundFloat(result.TotalDelta/float64(completedWeeks), 2)
+	}
+	result.TotalProjected = utils.RoundFloat(result.TotalProjected, 2)
+	result.TotalActual = utils.RoundFloat(result.TotalActual, 2)
+
+	for _, player := range seasonPlayers {
+		if result.TotalActual != 0 {
+			player.Share = utils.RoundFloat(player.Points/result.TotalActual, 3)
+		}
+		player.Points = utils.RoundFloat(player.Points, 2)
+		result.SeasonPlayers = append(result.SeasonPlayers, *player)
+	}
+	sort.Slice(result.SeasonPlayers, func(i, j int) bool {
+		return result.SeasonPlayers[i].Points > result.SeasonPlayers[j].Points
+	})
+
+	return result
+}
+
+func rosterHasPoints(players []models.RosterPlayer) bool {
+	for _, player := range players {
+		if player.Points != 0 {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// RecordPlayerStatuses stores the statuses seen in a Yahoo fetch and logs any that changed
+func RecordPlayerStatuses(fetched []models.PlayerStatus) error {
+	if len(fetched) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(fetched))
+	for _, status := range fetched {
+		keys = append(keys, status.PlayerKey)
+	}
+
+	stored, err := repositories.GetPlayerStatuses(keys)
+	if err != nil {
+		return err
+	}
+
+	statuses, changes := DiffPlayerStatuses(stored, fetched, time.Now())
+	return repositories.SavePlayerStatuses(statuses, changes)
+}
+
+// recordStatuses keeps a failed status write from failing the fetch that produced it
+func recordStatuses(fetched []models.PlayerStatus) {
+	if err := RecordPlayerStatuses(fetched); err != nil {
+		log.Printf("Failed to record player statuses: %v", err)
+	}
+}
+
+// DiffPlayerStatuses compares fetched statuses with stored ones. A player seen for the first time
+// only gets a change when they are already injured, so the first sync does not log every healthy player.
+func DiffPlayerStatuses(stored map[string]models.PlayerStatus, fetched []models.PlayerStatus, now time.Time) ([]models.PlayerStatus, []models.PlayerStatusChange) {
+	var statuses []models.PlayerStatus
+	var changes []models.PlayerStatusChange
+	seen := make(map[string]bool)
+
+	for _, status := range fetched {
+		if status.PlayerKey == "" || seen[status.PlayerKey] {
+			continue
+		}
+		seen[status.PlayerKey] = true
+
+		previous, known := stored[status.PlayerKey]
+		status.StatusSince = now
+		if known && previous.Status == status.Status {
+			status.StatusSince = previous.StatusSince
+		} else if known || status.Status != "" {
+			changes = append(changes, models.PlayerStatusChange{
+				PlayerKey:      status.PlayerKey,
+				PlayerName:     status.PlayerName,
+				PreviousStatus: previous.Status,
+				Status:         status.Status,
+				StatusFull:     status.StatusFull,
+				InjuryNote:     status.InjuryNote,
+				ChangedAt:      now,
+			})
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, changes
+}
+
+func rosterStatuses(players []models.RosterPlayer) []models.PlayerStatus {
+	statuses := make([]models.PlayerStatus, 0, len(players))
+	for _, player := range players {
+		statuses = append(statuses, models.PlayerStatus{
+			PlayerKey:  player.PlayerKey,
+			PlayerName: player.Name,
+			Status:     player.Status,
+			StatusFull: player.StatusFull,
+			InjuryNote: player.InjuryNote,
+		})
+	}
+	return statuses
+}
+
+func playerStatuses(players []models.Player) []models.PlayerStatus {
+	statuses := make([]models.PlayerStatus, 0, len(players))
+	for _, player := range players {
+		statuses = append(statuses, models.PlayerStatus{
+			PlayerKey:  player.PlayerKey,
+			PlayerName: player.Name.FullName,
+			Status:     player.Status,
+			StatusFull: player.StatusFull,
+			InjuryNote: player.InjuryNote,
+		})
+	}
+	return statuses
+}
+
+// GetLeagueInjuries lists the injured or unavailable players on every roster in a league
+func GetLeagueInjuries(sessionId, leagueId string) ([]models.TeamInjuries, error) {
+	rosters, err := GetLeagueRosters(sessionId, leagueId, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, roster := range rosters {
+		for _, player := range roster.Players {
+			keys = append(keys, player.PlayerKey)
+		}
+	}
+
+	stored, err := repositories.GetPlayerStatuses(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	injuries := make([]models.TeamInjuries, 0, len(rosters))
+	for _, roster := range rosters {
+		injuries = append(injuries, BuildTeamInjuries(roster, stored))
+	}
+	return injuries, nil
+}
+
+// BuildTeamInjuries lists a roster's players with a status, ruled out players first
+func BuildTeamInjuries(roster *models.TeamRoster, stored map[string]models.PlayerStatus) models.TeamInjuries {
+	injuries := models.TeamInjuries{
+		TeamKey: roster.TeamKey,
+		Players: []models.InjuredPlayer{},
+	}
+
+	var ruledOut, questionable []models.InjuredPlayer
+	for _, player := range roster.Players {
+		if player.Status == "" {
+			continue
+		}
+
+		injured := models.InjuredPlayer{
+			PlayerKey:        player.PlayerKey,
+			Name:             player.Name,
+			Team:             player.TeamAbbreviation,
+			SelectedPosition: player.SelectedPosition,
+			IsStarting:       player.IsStarting,
+			Status:           player.Status,
+			StatusFull:       player.StatusFull,
+			InjuryNote:       player.InjuryNote,
+			RuledOut:         utils.IsRuledOut(player.Status),
+		}
+		if status, ok := stored[player.PlayerKey]; ok && status.Status == player.Status {
+			injured.Since = status.StatusSince
+		}
+
+		if injured.RuledOut {
+			if player.IsStarting {
+				injuries.RuledOutStarters++
+			}
+			ruledOut = append(ruledOut, injured)
+		} else {
+			questionable = append(questionable, injured)
+		}
+	}
+
+	injuries.Players = append(injuries.Players, ruledOut...)
+	injuries.Players = append(injuries.Players, questionable...)
+	return injuries
+}
+
+// GetPlayerStatusHistory returns a Yahoo player's recorded status changes
+func GetPlayerStatusHistory(playerKey string) ([]models.PlayerStatusChange, error) {
+	changes, err := repositories.GetPlayerStatusHistory(playerKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, utils.NewNotFoundError(fmt.Sprintf("no status changes recorded for %s", playerKey))
+	}
+	return changes, nil
+}
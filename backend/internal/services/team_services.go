@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
 )
@@ -62,6 +63,11 @@ func GetTeamWeeklyStats(sessionId, teamId string) (map[int]map[string]string, er
 			"finalPoints":    teamWeekly.FinalPoints,
 		}
 
+		// The current week is still being played, so only completed weeks are stored
+		if week == currentWeek {
+			continue
+		}
+
 		// Insert new data into the database
 		err = repositories.AddTeamWeekData(teamId, week, teamWeekly.ProjectedPoints, teamWeekly.FinalPoints)
 		if err != nil {
@@ -71,3 +77,150 @@ func GetTeamWeeklyStats(sessionId, teamId string) (map[int]map[string]string, er
 
 	return weeklyStats, nil
 }
+
+// GetTeamRosterWeek fetches a fantasy team's roster for a week with each player's points for that week
+func GetTeamRosterWeek(sessionId, teamId string, week int) ([]models.RosterPlayer, error) {
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/team/%s/roster;week=%d/players/stats;type=week;week=%d", teamId, week, week)
+
+	roster, err := fetchTeamRoster(sessionId, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster for team %s week %d: %w", teamId, week, err)
+	}
+
+	return roster.Players, nil
+}
+
+// GetFantasyTeamRoster fetches and stores a fantasy team's lineup. A date is used for daily
+// leagues, otherwise the week; with neither Yahoo returns the current lineup.
+func GetFantasyTeamRoster(sessionId, teamId, week, date string) (*models.TeamRoster, error) {
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/team/%s/roster", teamId)
+	if date != "" {
+		url += fmt.Sprintf(";date=%s/players/stats;type=date;date=%s", date, date)
+	} else if week != "" {
+		url += fmt.Sprintf(";week=%s/players/stats;type=week;week=%s", week, week)
+	}
+
+	return fetchTeamRoster(sessionId, url)
+}
+
+// GetLeagueRosters fetches and stores the lineup of every team in the league
+func GetLeagueRosters(sessionId, leagueId, week, date string) ([]*models.TeamRoster, error) {
+	teams, err := GetAllTeamsInLeague(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var rosters []*models.TeamRoster
+	for _, team := range teams {
+		roster, err := GetFantasyTeamRoster(sessionId, team.TeamId, week, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get roster for team %s: %w", team.TeamId, err)
+		}
+		rosters = append(rosters, roster)
+	}
+
+	return rosters, nil
+}
+
+// GetStoredTeamRoster returns a stored lineup, or nil when the period was never fetched
+func GetStoredTeamRoster(teamId, coverageType, coverage string) (*models.TeamRoster, error) {
+	entries, err := repositories.GetRosterEntries(teamId, coverageType, coverage)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	return RostersFromEntries(entries)[0], nil
+}
+
+func GetFantasyTeamRosterHistory(teamId string) ([]*models.TeamRoster, error) {
+	entries, err := repositories.GetTeamRosterHistory(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return RostersFromEntries(entries), nil
+}
+
+func fetchTeamRoster(sessionId, url string) (*models.TeamRoster, error) {
+	rosterResponse, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch roster: %w", err)
+	}
+
+	roster, err := MapTeamRoster(rosterResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map roster: %w", err)
+	}
+
+	if err := repositories.SaveRosterEntries(RosterEntriesFromRoster(roster)); err != nil {
+		return nil, err
+	}
+	recordStatuses(rosterStatuses(roster.Players))
+
+	return roster, nil
+}
+
+// RosterEntriesFromRoster flattens a roster into one stored entry per player
+func RosterEntriesFromRoster(roster *models.TeamRoster) []models.RosterEntry {
+	var entries []models.RosterEntry
+	for _, player := range roster.Players {
+		entries = append(entries, models.RosterEntry{
+			TeamKey:           roster.TeamKey,
+			CoverageType:      roster.CoverageType,
+			Coverage:          roster.Coverage,
+			PlayerKey:         player.PlayerKey,
+			PlayerName:        player.Name,
+			TeamAbbreviation:  player.TeamAbbreviation,
+			DisplayPosition:   player.DisplayPosition,
+			PositionType:      player.PositionType,
+			EligiblePositions: player.EligiblePositions,
+			SelectedPosition:  player.SelectedPosition,
+			IsStarting:        player.IsStarting,
+			Points:            player.Points,
+			Status:            player.Status,
+			InjuryNote:        player.InjuryNote,
+		})
+	}
+	return entries
+}
+
+// RostersFromEntries groups stored entries into one roster per team and coverage period, in entry order
+func RostersFromEntries(entries []models.RosterEntry) []*models.TeamRoster {
+	var rosters []*models.TeamRoster
+	byPeriod := make(map[string]*models.TeamRoster)
+
+	for _, entry := range entries {
+		period := entry.TeamKey + "|" + entry.CoverageType + "|" + entry.Coverage
+		roster, ok := byPeriod[period]
+		if !ok {
+			roster = &models.TeamRoster{
+				TeamKey:      entry.TeamKey,
+				CoverageType: entry.CoverageType,
+				Coverage:     entry.Coverage,
+				Players:      []models.RosterPlayer{},
+			}
+			byPeriod[period] = roster
+			rosters = append(rosters, roster)
+		}
+
+		roster.Players = append(roster.Players, models.RosterPlayer{
+			PlayerKey:         entry.PlayerKey,
+			Name:              entry.PlayerName,
+			TeamAbbreviation:  entry.TeamAbbreviation,
+			DisplayPosition:   entry.DisplayPosition,
+			PositionType:      entry.PositionType,
+			EligiblePositions: entry.EligiblePositions,
+			SelectedPosition:  entry.SelectedPosition,
+			IsStarting:        entry.IsStarting,
+			Points:            entry.Points,
+			Status:            entry.Status,
+			InjuryNote:        entry.InjuryNote,
+		})
+	}
+
+	return rosters
+}
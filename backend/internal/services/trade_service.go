@@ -0,0 +1,378 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const defaultTradeWindow = "last30"
+
+// rateStatIDs are percentages and averages, which cannot be summed across players or games
+var rateStatIDs = map[string]bool{"15": true, "20": true, "26": true, "28": true, "29": true, "30": true, "34": true}
+
+// PlayerRate is a player's projected production per game
+type PlayerRate struct {
+	PointsPerGame float64
+	Source        string             // "season" or "gameLog"
+	Categories    map[string]float64 // Counting stats per game keyed by stat ID
+}
+
+// AnalyzeTrade projects both sides of a trade for the rest of the season
+func AnalyzeTrade(sessionId, leagueId string, request models.TradeAnalysisRequest) (*models.TradeAnalysis, error) {
+	window := request.Window
+	if window == "" {
+		window = defaultTradeWindow
+	}
+	windowDays, ok := WaiverWindows[window]
+	if !ok {
+		return nil, fmt.Errorf("unsupported window %s", window)
+	}
+
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	rosterA, err := GetFantasyTeamRoster(sessionId, request.TeamA, "", "")
+	if err != nil {
+		return nil, err
+	}
+	rosterB, err := GetFantasyTeamRoster(sessionId, request.TeamB, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	outgoingA, err := tradedPlayers(rosterA, request.TeamAGives)
+	if err != nil {
+		return nil, err
+	}
+	outgoingB, err := tradedPlayers(rosterB, request.TeamBGives)
+	if err != nil {
+		return nil, err
+	}
+
+	from, _ := time.Parse(lineupDateLayout, time.Now().Format(lineupDateLayout))
+	to := settings.EndDate
+	if to.IsZero() || to.Before(from) {
+		return nil, fmt.Errorf("league %s has no games left in the season", leagueId)
+	}
+
+	games, err := repositories.GetScheduleGamesBetween(from.Format(lineupDateLayout), to.Format(lineupDateLayout))
+	if err != nil {
+		return nil, err
+	}
+	remaining := gamesPerTeam(games)
+	opponents := opponentsByDate(games)
+
+	var active []models.RosterPlayer
+	for _, player := range append(rosterA.Players, rosterB.Players...) {
+		if !isReserveSlot(player.SelectedPosition) {
+			active = append(active, player)
+		}
+	}
+	rates, err := projectPlayerRates(sessionId, leagueId, settings.StatModifiers, active, windowDays, remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs := tradeCategoryIDs(settings.StatModifiers, rates)
+
+	analysis := &models.TradeAnalysis{
+		LeagueKey:      leagueId,
+		Window:         window,
+		FromDate:       from.Format(lineupDateLayout),
+		ToDate:         to.Format(lineupDateLayout),
+		WeeksRemaining: utils.RoundFloat((to.Sub(from).Hours()/24+1)/7, 2),
+		TeamA:          BuildTradeSide(request.TeamA, settings.RosterPositions, rosterA.Players, outgoingB, request.TeamAGives, rates, categoryIDs, opponents, from, to),
+		TeamB:          BuildTradeSide(request.TeamB, settings.RosterPositions, rosterB.Players, outgoingA, request.TeamBGives, rates, categoryIDs, opponents, from, to),
+	}
+
+	if analysis.TeamA.WeeklyPointsChange > analysis.TeamB.WeeklyPointsChange {
+		analysis.Favors = request.TeamA
+	} else if analysis.TeamB.WeeklyPointsChange > analysis.TeamA.WeeklyPointsChange {
+		analysis.Favors = request.TeamB
+	}
+
+	return analysis, nil
+}
+
+// tradedPlayers finds the traded players on a roster and fails when one is not there
+func tradedPlayers(roster *models.TeamRoster, playerKeys []string) ([]models.RosterPlayer, error) {
+	byKey := make(map[string]models.RosterPlayer)
+	for _, player := range roster.Players {
+		byKey[player.PlayerKey] = player
+	}
+
+	var players []models.RosterPlayer
+	for _, playerKey := range playerKeys {
+		player, ok := byKey[playerKey]
+		if !ok {
+			return nil, utils.NewNotFoundError(fmt.Sprintf("player %s is not on team %s", playerKey, roster.TeamKey))
+		}
+		// Acquired players join the bench until the optimizer places them
+		player.SelectedPosition = "BN"
+		players = append(players, player)
+	}
+
+	return players, nil
+}
+
+// projectPlayerRates projects every player's per-game rate from one batched season stats fetch
+// and one game log query
+func projectPlayerRates(sessionId, leagueId string, statModifiers []models.StatModifier, players []models.RosterPlayer, windowDays int, remaining map[string]int) (map[string]PlayerRate, error) {
+	rates := make(map[string]PlayerRate)
+	if len(players) == 0 {
+		return rates, nil
+	}
+
+	playerKeys := make([]string, 0, len(players))
+	for _, player := range players {
+		playerKeys = append(playerKeys, player.PlayerKey)
+	}
+
+	seasonStats, err := GetLeaguePlayersByKeys(sessionId, leagueId, playerKeys)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*models.Player)
+	for i := range seasonStats {
+		byKey[seasonStats[i].PlayerKey] = &seasonStats[i]
+	}
+
+	recent, err := recentGameLogs(playerKeys, windowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, player := range players {
+		rates[player.PlayerKey] = ProjectPlayerRate(statModifiers, player, byKey[player.PlayerKey], recent[player.PlayerKey], remaining)
+	}
+	return rates, nil
+}
+
+// ProjectPlayerRate rates a player from their window games, falling back to their season stats.
+// Category rates always come from the season. A player without season stats rates zero.
+func ProjectPlayerRate(statModifiers []models.StatModifier, player models.RosterPlayer, season *models.Player, windowGames []*models.PlayerGameStat, remaining map[string]int) PlayerRate {
+	rate := PlayerRate{Source: "season", Categories: map[string]float64{}}
+	if season == nil {
+		log.Printf("No season stats for player %s", player.PlayerKey)
+		return rate
+	}
+
+	seasonPerGame, err := seasonPointsPerGame(statModifiers, *season)
+	if err != nil {
+		log.Printf("Failed to score player %s: %v", player.PlayerKey, err)
+	}
+
+	scored := ScoreWaiverPlayer(statModifiers, WaiverPlayer{
+		PlayerKey:     player.PlayerKey,
+		Team:          player.TeamAbbreviation,
+		SeasonPerGame: seasonPerGame,
+	}, windowGames, remaining)
+	rate.PointsPerGame = scored.WindowPerGame
+	rate.Source = scored.WindowSource
+	rate.Categories = categoryRates(season.Stats)
+
+	return rate
+}
+
+// categoryRates divides each counting stat by games played
+func categoryRates(stats []models.Stat) map[string]float64 {
+	rates := make(map[string]float64)
+
+	gamesPlayed := 0.0
+	for _, stat := range stats {
+		if stat.StatID == "0" || (stat.StatID == "21" && gamesPlayed == 0) {
+			gamesPlayed, _ = strconv.ParseFloat(stat.Value, 64)
+		}
+	}
+	if gamesPlayed <= 0 {
+		return rates
+	}
+
+	for _, stat := range stats {
+		if stat.StatID == "0" || rateStatIDs[stat.StatID] {
+			continue
+		}
+		if value, err := strconv.ParseFloat(stat.Value, 64); err == nil {
+			rates[stat.StatID] = value / gamesPlayed
+		}
+	}
+
+	return rates
+}
+
+// tradeCategoryIDs returns the scored stats of a points league, or every counting stat seen otherwise
+func tradeCategoryIDs(statModifiers []models.StatModifier, rates map[string]PlayerRate) []string {
+	seen := make(map[string]bool)
+	if len(statModifiers) > 0 {
+		for _, modifier := range statModifiers {
+			if !rateStatIDs[modifier.StatID] {
+				seen[modifier.StatID] = true
+			}
+		}
+	} else {
+		for _, rate := range rates {
+			for statID := range rate.Categories {
+				seen[statID] = true
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(seen))
+	for statID := range seen {
+		ids = append(ids, statID)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		return a < b
+	})
+	return ids
+}
+
+// BuildTradeSide compares a team before and after a trade. Weekly points come from the lineup
+// optimizer over every remaining day, so a surplus at one position does not count in full.
+func BuildTradeSide(teamKey string, positions []models.RosterPosition, roster, incoming []models.RosterPlayer, gives []string, rates map[string]PlayerRate, categoryIDs []string, opponents map[string]map[string]string, from, to time.Time) models.TradeSide {
+	side := models.TradeSide{
+		TeamKey:    teamKey,
+		Gives:      []models.TradePlayerProjection{},
+		Receives:   []models.TradePlayerProjection{},
+		Categories: []models.CategoryChange{},
+	}
+
+	giving := make(map[string]bool)
+	for _, playerKey := range gives {
+		giving[playerKey] = true
+	}
+
+	var after []models.RosterPlayer
+	for _, player := range roster {
+		if giving[player.PlayerKey] {
+			continue
+		}
+		after = append(after, player)
+	}
+	after = append(after, incoming...)
+
+	remainingGames := make(map[string]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		for team := range opponents[day.Format(lineupDateLayout)] {
+			remainingGames[team]++
+		}
+	}
+
+	perGame := make(map[string]float64)
+	for playerKey, rate := range rates {
+		perGame[playerKey] = rate.PointsPerGame
+	}
+
+	project := func(player models.RosterPlayer) models.TradePlayerProjection {
+		games := remainingGames[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)]
+		rate := rates[player.PlayerKey]
+		return models.TradePlayerProjection{
+			PlayerKey:          player.PlayerKey,
+			Name:               player.Name,
+			Team:               player.TeamAbbreviation,
+			EligiblePositions:  player.EligiblePositions,
+			PointsPerGame:      utils.RoundFloat(rate.PointsPerGame, 2),
+			RateSource:         rate.Source,
+			RemainingGames:     games,
+			RestOfSeasonPoints: utils.RoundFloat(rate.PointsPerGame*float64(games), 2),
+		}
+	}
+
+	for _, player := range roster {
+		if giving[player.PlayerKey] {
+			projection := project(player)
+			side.Gives = append(side.Gives, projection)
+			side.PointsGiven += projection.RestOfSeasonPoints
+		}
+	}
+	for _, player := range incoming {
+		projection := project(player)
+		side.Receives = append(side.Receives, projection)
+		side.PointsReceived += projection.RestOfSeasonPoints
+	}
+	side.PointsGiven = utils.RoundFloat(side.PointsGiven, 2)
+	side.PointsReceived = utils.RoundFloat(side.PointsReceived, 2)
+	side.NetRestOfSeasonPoints = utils.RoundFloat(side.PointsReceived-side.PointsGiven, 2)
+
+	weeks := (to.Sub(from).Hours()/24 + 1) / 7
+	totalBefore, openBefore := simulateLineups(positions, roster, perGame, opponents, from, to)
+	totalAfter, openAfter := simulateLineups(positions, after, perGame, opponents, from, to)
+	if weeks > 0 {
+		side.WeeklyPointsBefore = utils.RoundFloat(totalBefore/weeks, 2)
+		side.WeeklyPointsAfter = utils.RoundFloat(totalAfter/weeks, 2)
+		side.WeeklyPointsChange = utils.RoundFloat((totalAfter-totalBefore)/weeks, 2)
+	}
+	side.OpenSlotsBefore = openBefore
+	side.OpenSlotsAfter = openAfter
+
+	capacity := 0
+	for _, position := range positions {
+		if !isReserveSlot(position.Position) {
+			capacity += position.Count
+		}
+	}
+	active := 0
+	for _, player := range after {
+		if !isReserveSlot(player.SelectedPosition) {
+			active++
+		}
+	}
+	if capacity > 0 && active > capacity {
+		side.ExcessPlayers = active - capacity
+	}
+
+	statNames := utils.GetStatIDToNameMap()
+	for _, statID := range categoryIDs {
+		before := projectCategory(statID, roster, rates, remainingGames)
+		afterTotal := projectCategory(statID, after, rates, remainingGames)
+		side.Categories = append(side.Categories, models.CategoryChange{
+			StatID: statID,
+			Name:   statNames[statID],
+			Before: utils.RoundFloat(before, 2),
+			After:  utils.RoundFloat(afterTotal, 2),
+			Change: utils.RoundFloat(afterTotal-before, 2),
+		})
+	}
+
+	return side
+}
+
+// simulateLineups totals optimal lineup points and empty starting slots over a date range
+func simulateLineups(positions []models.RosterPosition, players []models.RosterPlayer, perGame map[string]float64, opponents map[string]map[string]string, from, to time.Time) (float64, int) {
+	total := 0.0
+	openSlots := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format(lineupDateLayout)
+		lineup := OptimizeLineupForDay(date, positions, players, perGame, opponents[date])
+		total += lineup.ProjectedPoints
+		for _, slot := range lineup.Starters {
+			if slot.PlayerKey == "" {
+				openSlots++
+			}
+		}
+	}
+	return total, openSlots
+}
+
+func projectCategory(statID string, players []models.RosterPlayer, rates map[string]PlayerRate, remainingGames map[string]int) float64 {
+	total := 0.0
+	for _, player := range players {
+		if isReserveSlot(player.SelectedPosition) {
+			continue
+		}
+		games := remainingGames[utils.ToNHLTeamAbbreviation(player.TeamAbbreviation)]
+		total += rates[player.PlayerKey].Categories[statID] * float64(games)
+	}
+	return total
+}
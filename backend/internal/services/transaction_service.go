@@ -0,0 +1,339 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+const (
+	transactionLeaders    = 10
+	successfulTransaction = "successful"
+)
+
+// SyncLeagueTransactions fetches a league's transactions from Yahoo and stores them
+func SyncLeagueTransactions(sessionId, leagueId string) ([]models.Transaction, error) {
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/transactions", leagueId)
+	response, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transactions for league %s: %w", leagueId, err)
+	}
+
+	transactions, err := MapLeagueTransactions(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map transactions: %w", err)
+	}
+
+	if err := repositories.SaveTransactions(transactions); err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// GetLeagueTransactions syncs a league's transactions and returns the stored ones matching the filter
+func GetLeagueTransactions(sessionId, leagueId string, filter models.TransactionFilter) ([]models.Transaction, error) {
+	if _, err := SyncLeagueTransactions(sessionId, leagueId); err != nil {
+		return nil, err
+	}
+
+	transactions, err := repositories.GetLeagueTransactions(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterTransactions(transactions, filter), nil
+}
+
+// FilterTransactions keeps the transactions matching every set filter field
+func FilterTransactions(transactions []models.Transaction, filter models.TransactionFilter) []models.Transaction {
+	filtered := []models.Transaction{}
+	for _, transaction := range transactions {
+		date := transaction.Timestamp.Format(lineupDateLayout)
+		if filter.Since != "" && date < filter.Since {
+			continue
+		}
+		if filter.Until != "" && date > filter.Until {
+			continue
+		}
+		if filter.Type == "waiver" {
+			if !hasWaiverClaim(transaction) {
+				continue
+			}
+		} else if filter.Type != "" && transaction.Type != filter.Type {
+			continue
+		}
+		if filter.TeamKey != "" && !transactionInvolvesTeam(transaction, filter.TeamKey) {
+			continue
+		}
+		if filter.PlayerKey != "" && !transactionMovesPlayer(transaction, filter.PlayerKey) {
+			continue
+		}
+		filtered = append(filtered, transaction)
+	}
+	return filtered
+}
+
+// AnalyzeLeagueTransactions reports the most added and dropped players, each manager's activity
+// and the fantasy points pickups scored after they were added
+func AnalyzeLeagueTransactions(sessionId, leagueId string) (*models.TransactionAnalysis, error) {
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := GetAllTeamsInLeague(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+	teamNames := make(map[string]string)
+	for _, team := range teams {
+		teamNames[team.TeamId] = team.Name
+	}
+
+	if _, err := SyncLeagueTransactions(sessionId, leagueId); err != nil {
+		return nil, err
+	}
+	transactions, err := repositories.GetLeagueTransactions(leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	season := utils.GetCurrentNhlSeason()
+	if !settings.StartDate.IsZero() {
+		season = utils.NhlSeasonForDate(settings.StartDate)
+	}
+
+	var pickups []string
+	seen := make(map[string]bool)
+	for _, transaction := range transactions {
+		for _, player := range transaction.Players {
+			if player.Type == "add" && !seen[player.PlayerKey] {
+				seen[player.PlayerKey] = true
+				pickups = append(pickups, player.PlayerKey)
+			}
+		}
+	}
+	nhlIds, err := nhlPlayerIDs(pickups)
+	if err != nil {
+		return nil, err
+	}
+
+	gameLogs := make(map[string][]*models.PlayerGameStat)
+	for _, playerKey := range pickups {
+		nhlId, ok := nhlIds[playerKey]
+		if !ok {
+			log.Printf("No NHL player mapped to %s, so their pickup scores no points", playerKey)
+			continue
+		}
+		gameLogs[playerKey] = pickupGameLog(playerKey, nhlId, season)
+	}
+
+	analysis := BuildTransactionAnalysis(transactions, teamNames, settings.StatModifiers, gameLogs)
+	analysis.LeagueKey = leagueId
+	return &analysis, nil
+}
+
+// pickupGameLog returns a player's regular season games in the league's season, fetching that
+// season when none of it is stored
+func pickupGameLog(playerKey, nhlId, season string) []*models.PlayerGameStat {
+	seasonId, err := strconv.Atoi(season)
+	if err != nil {
+		log.Printf("Invalid season %s: %v", season, err)
+		return nil
+	}
+
+	stored, err := repositories.GetPlayerGameStatsDB(nhlId)
+	if err != nil {
+		log.Printf("Failed to get game log for player %s: %v", playerKey, err)
+		return nil
+	}
+	games := seasonGames(stored, seasonId)
+	if len(games) == 0 {
+		fetched, err := GetPlayerGameStatsNHL(nhlId, season, models.RegularSeasonGame)
+		if err != nil {
+			log.Printf("Failed to fetch game log for player %s: %v", playerKey, err)
+			return nil
+		}
+		games = seasonGames(fetched, seasonId)
+	}
+	return games
+}
+
+// BuildTransactionAnalysis aggregates successful transactions. A pickup scores the games played
+// from the day it was added until the day the same team dropped or traded the player, within the
+// season it was added in.
+func BuildTransactionAnalysis(transactions []models.Transaction, teamNames map[string]string, statModifiers []models.StatModifier, gameLogs map[string][]*models.PlayerGameStat) models.TransactionAnalysis {
+	analysis := models.TransactionAnalysis{
+		Pickups: []models.PickupValue{},
+	}
+
+	var successful []models.Transaction
+	for _, transaction := range transactions {
+		if transaction.Status == "" || transaction.Status == successfulTransaction {
+			successful = append(successful, transaction)
+		}
+	}
+	sort.SliceStable(successful, func(i, j int) bool { return successful[i].Timestamp.Before(successful[j].Timestamp) })
+
+	managers := make(map[string]*models.ManagerActivity)
+	manager := func(teamKey string) *models.ManagerActivity {
+		activity, ok := managers[teamKey]
+		if !ok {
+			activity = &models.ManagerActivity{TeamKey: teamKey, TeamName: teamNames[teamKey]}
+			managers[teamKey] = activity
+		}
+		return activity
+	}
+	for teamKey := range teamNames {
+		manager(teamKey)
+	}
+
+	added := make(map[string]*models.PlayerMoveCount)
+	dropped := make(map[string]*models.PlayerMoveCount)
+	count := func(counts map[string]*models.PlayerMoveCount, player models.TransactionPlayer) {
+		if _, ok := counts[player.PlayerKey]; !ok {
+			counts[player.PlayerKey] = &models.PlayerMoveCount{PlayerKey: player.PlayerKey, PlayerName: player.PlayerName}
+		}
+		counts[player.PlayerKey].Count++
+	}
+
+	for i, transaction := range successful {
+		if transaction.Type == "trade" {
+			manager(transaction.TraderTeamKey).Trades++
+			manager(transaction.TradeeTeamKey).Trades++
+		}
+
+		for _, player := range transaction.Players {
+			switch player.Type {
+			case "add":
+				count(added, player)
+				activity := manager(player.DestinationTeamKey)
+				activity.Adds++
+				if player.SourceType == "waivers" {
+					activity.WaiverClaims++
+					activity.FaabSpent += transaction.FaabBid
+				}
+
+				pickup := pickupValue(statModifiers, transaction, player, successful[i+1:], gameLogs[player.PlayerKey])
+				activity.PickupPoints += pickup.Points
+				analysis.Pickups = append(analysis.Pickups, pickup)
+			case "drop":
+				count(dropped, player)
+				manager(player.SourceTeamKey).Drops++
+			}
+		}
+	}
+
+	analysis.MostAdded = topMoveCounts(added)
+	analysis.MostDropped = topMoveCounts(dropped)
+
+	for _, activity := range managers {
+		activity.PickupPoints = utils.RoundFloat(activity.PickupPoints, 2)
+		analysis.Managers = append(analysis.Managers, *activity)
+	}
+	sort.Slice(analysis.Managers, func(i, j int) bool {
+		a, b := analysis.Managers[i], analysis.Managers[j]
+		if moves, other := a.Adds+a.Drops+a.Trades, b.Adds+b.Drops+b.Trades; moves != other {
+			return moves > other
+		}
+		return a.TeamKey < b.TeamKey
+	})
+	if analysis.Managers == nil {
+		analysis.Managers = []models.ManagerActivity{}
+	}
+
+	sort.SliceStable(analysis.Pickups, func(i, j int) bool { return analysis.Pickups[i].Points > analysis.Pickups[j].Points })
+	return analysis
+}
+
+// pickupValue scores an added player's games until the adding team let them go
+func pickupValue(statModifiers []models.StatModifier, transaction models.Transaction, player models.TransactionPlayer, later []models.Transaction, games []*models.PlayerGameStat) models.PickupValue {
+	teamKey := player.DestinationTeamKey
+	pickup := models.PickupValue{
+		PlayerKey:  player.PlayerKey,
+		PlayerName: player.PlayerName,
+		TeamKey:    teamKey,
+		AddedAt:    transaction.Timestamp,
+	}
+	if player.SourceType == "waivers" {
+		pickup.FaabBid = transaction.FaabBid
+	}
+
+	for _, next := range later {
+		for _, move := range next.Players {
+			if move.PlayerKey == player.PlayerKey && move.SourceTeamKey == teamKey && (move.Type == "drop" || move.Type == "trade") {
+				pickup.DroppedAt = next.Timestamp.Format(lineupDateLayout)
+				break
+			}
+		}
+		if pickup.DroppedAt != "" {
+			break
+		}
+	}
+
+	addedOn := transaction.Timestamp.Format(lineupDateLayout)
+	season, _ := strconv.Atoi(utils.NhlSeasonForDate(transaction.Timestamp))
+	points := 0.0
+	for _, game := range games {
+		if gameSeason(game) != season || game.GameDate < addedOn || (pickup.DroppedAt != "" && game.GameDate >= pickup.DroppedAt) {
+			continue
+		}
+		pickup.Games++
+		points += GameFantasyPoints(statModifiers, game)
+	}
+	pickup.Points = utils.RoundFloat(points, 2)
+
+	return pickup
+}
+
+func topMoveCounts(counts map[string]*models.PlayerMoveCount) []models.PlayerMoveCount {
+	leaders := make([]models.PlayerMoveCount, 0, len(counts))
+	for _, count := range counts {
+		leaders = append(leaders, *count)
+	}
+	sort.Slice(leaders, func(i, j int) bool {
+		if leaders[i].Count != leaders[j].Count {
+			return leaders[i].Count > leaders[j].Count
+		}
+		return leaders[i].PlayerName < leaders[j].PlayerName
+	})
+	if len(leaders) > transactionLeaders {
+		leaders = leaders[:transactionLeaders]
+	}
+	return leaders
+}
+
+func hasWaiverClaim(transaction models.Transaction) bool {
+	for _, player := range transaction.Players {
+		if player.Type == "add" && player.SourceType == "waivers" {
+			return true
+		}
+	}
+	return false
+}
+
+func transactionInvolvesTeam(transaction models.Transaction, teamKey string) bool {
+	if transaction.TraderTeamKey == teamKey || transaction.TradeeTeamKey == teamKey {
+		return true
+	}
+	for _, player := range transaction.Players {
+		if player.SourceTeamKey == teamKey || player.DestinationTeamKey == teamKey {
+			return true
+		}
+	}
+	return false
+}
+
+func transactionMovesPlayer(transaction models.Transaction, playerKey string) bool {
+	for _, player := range transaction.Players {
+		if player.PlayerKey == playerKey {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,370 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+// WaiverWindows maps each supported scoring window to its length in days, zero for the full season
+var WaiverWindows = map[string]int{
+	"season": 0,
+	"last7":  7,
+	"last14": 14,
+	"last30": 30,
+}
+
+// waiverRankTypes maps each scoring window to the Yahoo rank covering the same period
+var waiverRankTypes = map[string]string{
+	"season": "S",
+	"last7":  "L7",
+	"last14": "L14",
+	"last30": "L30",
+}
+
+// Without games in the window, the season rate is scaled by how much better the player ranks over
+// the window than over the season, within these bounds
+const (
+	rankFormMinimum = 0.5
+	rankFormMaximum = 1.5
+)
+
+// WaiverPlayer is a free agent or rostered player scored for the waiver comparison
+type WaiverPlayer struct {
+	PlayerKey         string
+	Name              string
+	Team              string
+	EligiblePositions []string
+	SeasonPerGame     float64
+	SeasonRank        int // Yahoo league ranks, zero when unknown
+	WindowRank        int
+	WindowPerGame     float64
+	WindowGames       int
+	WindowSource      string
+	UpcomingGames     int
+	Projected         float64
+}
+
+// GetWaiverRecommendations ranks a league's free agents as pickups for a fantasy team. Free agents
+// are projected over the next days from their points per game in the window and compared with the
+// weakest rostered player sharing a position.
+func GetWaiverRecommendations(sessionId, teamId, window, position string, days, count int) (*models.WaiverRecommendations, error) {
+	windowDays, ok := WaiverWindows[window]
+	if !ok {
+		return nil, fmt.Errorf("unsupported window %s", window)
+	}
+
+	leagueId, err := utils.TeamtoLeagueId(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := GetLeagueSettings(sessionId, leagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	freeAgents, err := GetLeagueFreeAgents(sessionId, leagueId, position, count)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err := GetFantasyTeamRoster(sessionId, teamId, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Now()
+	to := from.AddDate(0, 0, days-1)
+	games, err := repositories.GetScheduleGamesBetween(from.Format(lineupDateLayout), to.Format(lineupDateLayout))
+	if err != nil {
+		return nil, err
+	}
+	upcoming := gamesPerTeam(games)
+
+	var rosterKeys []string
+	for _, player := range roster.Players {
+		if !isReserveSlot(player.SelectedPosition) {
+			rosterKeys = append(rosterKeys, player.PlayerKey)
+		}
+	}
+	freeAgentKeys := make([]string, 0, len(freeAgents))
+	for _, freeAgent := range freeAgents {
+		freeAgentKeys = append(freeAgentKeys, freeAgent.PlayerKey)
+	}
+
+	rosterStats, err := GetLeaguePlayersByKeys(sessionId, leagueId, rosterKeys)
+	if err != nil {
+		return nil, err
+	}
+	rosterSeason := make(map[string]float64)
+	for _, player := range rosterStats {
+		seasonPerGame, err := seasonPointsPerGame(settings.StatModifiers, player)
+		if err != nil {
+			log.Printf("Failed to score rostered player %s: %v", player.PlayerKey, err)
+		}
+		rosterSeason[player.PlayerKey] = seasonPerGame
+	}
+
+	allKeys := append(append([]string(nil), rosterKeys...), freeAgentKeys...)
+	recent, err := recentGameLogs(allKeys, windowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks, err := GetPlayerRanksByKeys(sessionId, leagueId, allKeys)
+	if err != nil {
+		log.Printf("Failed to get player ranks in league %s: %v", leagueId, err)
+	}
+	seasonRank := func(playerKey string) int { return rankValue(ranks[playerKey], waiverRankTypes["season"]) }
+	windowRank := func(playerKey string) int { return rankValue(ranks[playerKey], waiverRankTypes[window]) }
+
+	var rostered []WaiverPlayer
+	for _, player := range roster.Players {
+		if isReserveSlot(player.SelectedPosition) {
+			continue
+		}
+		rostered = append(rostered, ScoreWaiverPlayer(settings.StatModifiers, WaiverPlayer{
+			PlayerKey:         player.PlayerKey,
+			Name:              player.Name,
+			Team:              player.TeamAbbreviation,
+			EligiblePositions: player.EligiblePositions,
+			SeasonPerGame:     rosterSeason[player.PlayerKey],
+			SeasonRank:        seasonRank(player.PlayerKey),
+			WindowRank:        windowRank(player.PlayerKey),
+		}, recent[player.PlayerKey], upcoming))
+	}
+
+	result := &models.WaiverRecommendations{
+		TeamKey:    teamId,
+		Window:     window,
+		FromDate:   from.Format(lineupDateLayout),
+		ToDate:     to.Format(lineupDateLayout),
+		Candidates: []models.WaiverCandidate{},
+	}
+
+	for _, freeAgent := range freeAgents {
+		seasonPerGame, err := seasonPointsPerGame(settings.StatModifiers, freeAgent)
+		if err != nil {
+			log.Printf("Failed to score free agent %s: %v", freeAgent.PlayerKey, err)
+		}
+
+		scored := ScoreWaiverPlayer(settings.StatModifiers, WaiverPlayer{
+			PlayerKey:         freeAgent.PlayerKey,
+			Name:              freeAgent.Name.FullName,
+			Team:              freeAgent.TeamAbbreviation,
+			EligiblePositions: freeAgent.EligiblePositions,
+			SeasonPerGame:     seasonPerGame,
+			SeasonRank:        seasonRank(freeAgent.PlayerKey),
+			WindowRank:        windowRank(freeAgent.PlayerKey),
+		}, recent[freeAgent.PlayerKey], upcoming)
+
+		candidate := BuildWaiverCandidate(scored, rostered)
+		candidate.DisplayPosition = freeAgent.DisplayPosition
+		candidate.Ranks = ranks[freeAgent.PlayerKey]
+		if candidate.Ranks == nil {
+			candidate.Ranks = []models.PlayerRank{}
+		}
+		result.Candidates = append(result.Candidates, candidate)
+	}
+
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		a, b := result.Candidates[i], result.Candidates[j]
+		if a.Gain != b.Gain {
+			return a.Gain > b.Gain
+		}
+		// Equal gains go to the player Yahoo ranks better over the window
+		return a.WindowRank > 0 && (b.WindowRank == 0 || a.WindowRank < b.WindowRank)
+	})
+
+	return result, nil
+}
+
+// ScoreWaiverPlayer fills in the window rate from the player's regular season games in the window.
+// Without games it falls back to the season rate, adjusted for form when the player's Yahoo rank
+// over the window differs from their season rank.
+func ScoreWaiverPlayer(statModifiers []models.StatModifier, player WaiverPlayer, windowGames []*models.PlayerGameStat, upcoming map[string]int) WaiverPlayer {
+	player.WindowPerGame = player.SeasonPerGame
+	player.WindowSource = "season"
+
+	if len(windowGames) == 0 && player.SeasonRank > 0 && player.WindowRank > 0 && player.WindowRank != player.SeasonRank {
+		form := math.Max(rankFormMinimum, math.Min(rankFormMaximum, float64(player.SeasonRank)/float64(player.WindowRank)))
+		player.WindowPerGame = player.SeasonPerGame * form
+		player.WindowSource = "rank"
+	}
+
+	if len(windowGames) > 0 {
+		total := 0.0
+		for _, game := range windowGames {
+			total += GameFantasyPoints(statModifiers, game)
+		}
+		player.WindowPerGame = total / float64(len(windowGames))
+		player.WindowGames = len(windowGames)
+		player.WindowSource = "gameLog"
+	}
+
+	player.UpcomingGames = upcoming[utils.ToNHLTeamAbbreviation(player.Team)]
+	player.Projected = player.WindowPerGame * float64(player.UpcomingGames)
+	return player
+}
+
+// recentGameLogs reads each player's games from the current regular season within the last days.
+// Stored logs come from one query, and logs missing games their team has since completed are
+// refetched from the NHL. A zero-day window is the full season and needs no games.
+func recentGameLogs(playerKeys []string, days int) (map[string][]*models.PlayerGameStat, error) {
+	logs := make(map[string][]*models.PlayerGameStat)
+	if days <= 0 || len(playerKeys) == 0 {
+		return logs, nil
+	}
+
+	nhlIds, err := nhlPlayerIDs(playerKeys)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(nhlIds))
+	for _, nhlId := range nhlIds {
+		ids = append(ids, nhlId)
+	}
+
+	stored, err := repositories.GetPlayersGameStatsSince(ids, currentSeasonStart())
+	if err != nil {
+		return nil, err
+	}
+	storedByPlayer := make(map[string][]*models.PlayerGameStat)
+	for _, game := range stored {
+		storedByPlayer[game.PlayerID] = append(storedByPlayer[game.PlayerID], game)
+	}
+
+	lastCompleted, err := lastCompletedGameDates()
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().AddDate(0, 0, -days).Format(lineupDateLayout)
+	for playerKey, nhlId := range nhlIds {
+		games, err := currentSeasonGameLog(nhlId, storedByPlayer[nhlId], lastCompleted)
+		if err != nil {
+			log.Printf("Failed to refresh game log for %s: %v", playerKey, err)
+			games = currentSeasonGames(storedByPlayer[nhlId])
+		}
+		for _, game := range games {
+			if game.GameDate >= since {
+				logs[playerKey] = append(logs[playerKey], game)
+			}
+		}
+	}
+
+	return logs, nil
+}
+
+// nhlPlayerIDs maps each Yahoo player key, from any season's game, to its NHL id in one query.
+// Unmapped players are left out.
+func nhlPlayerIDs(playerKeys []string) (map[string]string, error) {
+	mappings, err := repositories.GetPlayerIDMappingsByYahooIDs(playerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	byPlayerId := make(map[string]string)
+	for _, mapping := range mappings {
+		if mapping.NHLPlayerID != "" {
+			byPlayerId[utils.YahooPlayerID(mapping.YahooPlayerID)] = mapping.NHLPlayerID
+		}
+	}
+
+	nhlIds := make(map[string]string)
+	for _, playerKey := range playerKeys {
+		if nhlId, ok := byPlayerId[utils.YahooPlayerID(playerKey)]; ok {
+			nhlIds[playerKey] = nhlId
+		}
+	}
+	return nhlIds, nil
+}
+
+// rankValue returns a player's rank of the given type, or zero when Yahoo has none
+func rankValue(ranks []models.PlayerRank, rankType string) int {
+	for _, rank := range ranks {
+		if rank.RankType == rankType && rank.RankValue > 0 {
+			return rank.RankValue
+		}
+	}
+	return 0
+}
+
+func gamesPerTeam(games []models.ScheduleGame) map[string]int {
+	counts := make(map[string]int)
+	for _, game := range games {
+		counts[game.HomeTeamAbbrev]++
+		counts[game.AwayTeamAbbrev]++
+	}
+	return counts
+}
+
+// BuildWaiverCandidate compares a scored free agent with the weakest rostered player sharing one
+// of its positions. Util is ignored since every skater shares it.
+func BuildWaiverCandidate(freeAgent WaiverPlayer, rostered []WaiverPlayer) models.WaiverCandidate {
+	candidate := models.WaiverCandidate{
+		PlayerKey:           freeAgent.PlayerKey,
+		Name:                freeAgent.Name,
+		Team:                freeAgent.Team,
+		EligiblePositions:   freeAgent.EligiblePositions,
+		SeasonPointsPerGame: utils.RoundFloat(freeAgent.SeasonPerGame, 2),
+		WindowPointsPerGame: utils.RoundFloat(freeAgent.WindowPerGame, 2),
+		WindowGames:         freeAgent.WindowGames,
+		WindowSource:        freeAgent.WindowSource,
+		WindowRank:          freeAgent.WindowRank,
+		UpcomingGames:       freeAgent.UpcomingGames,
+		ProjectedPoints:     utils.RoundFloat(freeAgent.Projected, 2),
+		Gain:                utils.RoundFloat(freeAgent.Projected, 2),
+	}
+
+	var weakest *WaiverPlayer
+	sharedPosition := ""
+	for i := range rostered {
+		position := SharedEligiblePosition(freeAgent.EligiblePositions, rostered[i].EligiblePositions)
+		if position == "" {
+			continue
+		}
+		if weakest == nil || rostered[i].Projected < weakest.Projected {
+			weakest = &rostered[i]
+			sharedPosition = position
+		}
+	}
+
+	if weakest != nil {
+		candidate.DropCandidate = &models.DropCandidate{
+			PlayerKey:       weakest.PlayerKey,
+			Name:            weakest.Name,
+			Position:        sharedPosition,
+			PointsPerGame:   utils.RoundFloat(weakest.WindowPerGame, 2),
+			UpcomingGames:   weakest.UpcomingGames,
+			ProjectedPoints: utils.RoundFloat(weakest.Projected, 2),
+		}
+		candidate.Gain = utils.RoundFloat(freeAgent.Projected-weakest.Projected, 2)
+	}
+	candidate.Recommended = candidate.Gain > 0
+
+	return candidate
+}
+
+// SharedEligiblePosition returns the first real position two players can both fill, ignoring Util,
+// bench and reserve slots
+func SharedEligiblePosition(a, b []string) string {
+	for _, positionA := range a {
+		if positionA == "Util" || utils.IsBenchPosition(positionA) || isReserveSlot(positionA) {
+			continue
+		}
+		for _, positionB := range b {
+			if positionA == positionB {
+				return positionA
+			}
+		}
+	}
+	return ""
+}
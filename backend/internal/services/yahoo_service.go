@@ -6,6 +6,7 @@ import (
 	"log"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
@@ -14,6 +15,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// Yahoo caps player collections at 25 players per request
+const yahooPlayerBatch = 25
+
 func GetUserLeagues(userSession string) (map[string]interface{}, error) {
 
 	url := "https://fantasysports.yahooapis.com/fantasy/v2/users;use_login=1/games/leagues"
@@ -166,6 +170,7 @@ func GetPlayerStats(sessionId, playerId string) (*models.Player, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to map player data: %w", err)
 	}
+	recordStatuses(playerStatuses([]models.Player{*player}))
 
 	// Save the updated stats to the database
 	err = repositories.SavePlayerStatsDB(*player)
@@ -176,8 +181,11 @@ func GetPlayerStats(sessionId, playerId string) (*models.Player, error) {
 	return player, nil
 }
 
+// playerRankTypes are the ranks requested for a player
+const playerRankTypes = "season,last30days,last14days,last7days,projected_next7days,projected_next14days,projected_season_remaining"
+
 func GetPlayerRankLeague(sessionId, leagueId, playerId string) ([]models.PlayerRank, error) {
-	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/leagues;league_keys=%s/players;player_keys=%s;out=ranks;ranks=season,last30days,last14days,last7days,projected_next7days,projected_next14days,projected_season_remaining", leagueId, playerId)
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/leagues;league_keys=%s/players;player_keys=%s;out=ranks;ranks=%s", leagueId, playerId, playerRankTypes)
 	playerRankResponse, err := AuthHttpXMLRequest(sessionId, url)
 	if err != nil {
 		return nil, err
@@ -191,6 +199,127 @@ func GetPlayerRankLeague(sessionId, leagueId, playerId string) ([]models.PlayerR
 	return playerRanks, nil
 }
 
+// GetPlayerRanksByKeys returns league ranks keyed by player key, fetched 25 keys per request
+func GetPlayerRanksByKeys(sessionId, leagueId string, playerKeys []string) (map[string][]models.PlayerRank, error) {
+	ranks := make(map[string][]models.PlayerRank)
+	for start := 0; start < len(playerKeys); start += yahooPlayerBatch {
+		end := start + yahooPlayerBatch
+		if end > len(playerKeys) {
+			end = len(playerKeys)
+		}
+
+		url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/players;player_keys=%s;out=ranks;ranks=%s", leagueId, strings.Join(playerKeys[start:end], ","), playerRankTypes)
+		response, err := AuthHttpXMLRequest(sessionId, url)
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := MapPlayerRanksByKey(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map player ranks: %w", err)
+		}
+		for playerKey, playerRanks := range batch {
+			ranks[playerKey] = playerRanks
+		}
+	}
+
+	return ranks, nil
+}
+
+// GetLeagueFreeAgents returns the league's best available free agents by actual rank with season stats
+func GetLeagueFreeAgents(sessionId, leagueId, position string, count int) ([]models.Player, error) {
+	filters := fmt.Sprintf(";status=FA;sort=AR;count=%d", count)
+	if position != "" {
+		filters += ";position=" + position
+	}
+
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/players%s/stats;type=season", leagueId, filters)
+	freeAgentsResponse, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, err
+	}
+
+	freeAgents, err := MapLeaguePlayers(freeAgentsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map free agents: %w", err)
+	}
+	recordStatuses(playerStatuses(freeAgents))
+
+	return freeAgents, nil
+}
+
+// GetGameWeeks returns the fantasy week boundaries of the game a league belongs to
+func GetGameWeeks(sessionId, leagueId string) ([]models.GameWeek, error) {
+	gameKey, _, found := strings.Cut(leagueId, ".l.")
+	if !found {
+		return nil, fmt.Errorf("invalid league ID format: %s", leagueId)
+	}
+
+	url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/game/%s/game_weeks", gameKey)
+	response, err := AuthHttpXMLRequest(sessionId, url)
+	if err != nil {
+		return nil, err
+	}
+
+	weeks, err := MapGameWeeks(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map game weeks: %w", err)
+	}
+	return weeks, nil
+}
+
+// GetLeaguePlayersByKeys returns league players with season stats, fetched 25 keys per request
+func GetLeaguePlayersByKeys(sessionId, leagueId string, playerKeys []string) ([]models.Player, error) {
+	var players []models.Player
+	for start := 0; start < len(playerKeys); start += yahooPlayerBatch {
+		end := start + yahooPlayerBatch
+		if end > len(playerKeys) {
+			end = len(playerKeys)
+		}
+
+		url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/players;player_keys=%s/stats;type=season", leagueId, strings.Join(playerKeys[start:end], ","))
+		response, err := AuthHttpXMLRequest(sessionId, url)
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := MapLeaguePlayers(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map league players: %w", err)
+		}
+		players = append(players, batch...)
+	}
+
+	recordStatuses(playerStatuses(players))
+	return players, nil
+}
+
+// GetLeaguePlayersByPreseasonRank returns the league's top players by Yahoo's preseason rank
+func GetLeaguePlayersByPreseasonRank(sessionId, leagueId string, count int) ([]models.Player, error) {
+	var players []models.Player
+	for start := 0; start < count; start += yahooPlayerBatch {
+		url := fmt.Sprintf("https://fantasysports.yahooapis.com/fantasy/v2/league/%s/players;sort=OR;start=%d;count=%d", leagueId, start, yahooPlayerBatch)
+		response, err := AuthHttpXMLRequest(sessionId, url)
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := MapLeaguePlayers(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map league players: %w", err)
+		}
+		players = append(players, batch...)
+		if len(batch) < yahooPlayerBatch {
+			break
+		}
+	}
+
+	if len(players) > count {
+		players = players[:count]
+	}
+	return players, nil
+}
+
 func GetAllNhlPlayersYahoo(sessionId string) ([]*models.YahooPlayer, error) {
 	gameKey := "453"
 	var allPlayers []*models.YahooPlayer
@@ -210,9 +339,18 @@ func GetAllNhlPlayersYahoo(sessionId string) ([]*models.YahooPlayer, error) {
 		}
 
 		var playerPtrs []*models.YahooPlayer
+		var statuses []models.PlayerStatus
 		for i := range players {
 			playerPtrs = append(playerPtrs, &players[i])
+			statuses = append(statuses, models.PlayerStatus{
+				PlayerKey:  players[i].ID,
+				PlayerName: players[i].FullName,
+				Status:     players[i].Status,
+				StatusFull: players[i].StatusFull,
+				InjuryNote: players[i].InjuryNote,
+			})
 		}
+		recordStatuses(statuses)
 
 		// If no players are found, break the loop
 		if len(players) == 0 {
@@ -239,7 +377,7 @@ func GetAllTeamsInLeague(sessionId, leagueId string) ([]models.LeagueTeam, error
 
 	leagueTeamsFromDB, err := repositories.GetAllLeagueTeamsFromDB(leagueId)
 	if err != nil {
-		log.Printf("Failed to get league teams from DB: %w", err)
+		log.Printf("Failed to get league teams from DB: %v", err)
 	}
 
 	if leagueTeamsFromDB != nil {
@@ -261,7 +399,7 @@ func GetAllTeamsInLeague(sessionId, leagueId string) ([]models.LeagueTeam, error
 
 	err = repositories.SaveLeagueTeamsToDB(teams)
 	if err != nil {
-		log.Printf("Failed to save teams in DB: %w", err)
+		log.Printf("Failed to save teams in DB: %v", err)
 	}
 
 	return teams, nil
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func TestNhlSeasonForDate(t *testing.T) {
+	cases := map[string]string{
+		"2025-06-20": "20242025", // Stanley Cup final
+		"2025-08-15": "20242025", // Offseason still reports the finished season
+		"2025-09-01": "20252026",
+		"2026-01-10": "20252026",
+	}
+	for date, expected := range cases {
+		day, _ := time.Parse("2006-01-02", date)
+		if season := utils.NhlSeasonForDate(day); season != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, date, season)
+		}
+	}
+
+	previous := utils.PreviousNhlSeasons("20252026", 2)
+	if len(previous) != 2 || previous[0] != "20242025" || previous[1] != "20232024" {
+		t.Errorf("Expected the two prior seasons most recent first, got %v", previous)
+	}
+}
+
+func TestAggregateGameLogs(t *testing.T) {
+	games := []*models.PlayerGameStat{
+		{GameDate: "2023-11-01", TeamAbbrev: "EDM", Goals: 1, Points: 2, TOI: "20:00"}, // Stored before seasons were recorded
+		{GameDate: "2024-11-01", Season: 20242025, GameType: models.RegularSeasonGame, TeamAbbrev: "EDM", Points: 1, TOI: "18:00"},
+		{GameDate: "2024-11-03", Season: 20242025, GameType: models.RegularSeasonGame, TeamAbbrev: "TOR", Goals: 2, Points: 3, TOI: "22:00"},
+		{GameDate: "2025-05-02", Season: 20242025, GameType: models.PlayoffGame, TeamAbbrev: "TOR", Goals: 1, Points: 1, TOI: "24:00"},
+	}
+
+	career := services.AggregateGameLogs(games)
+	if career.RegularSeason.GamesPlayed != 3 || career.RegularSeason.Points != 6 || career.RegularSeason.PointsPerGame != 2 {
+		t.Errorf("Expected 6 regular season points in 3 games, got %+v", career.RegularSeason)
+	}
+	if career.Playoffs.GamesPlayed != 1 || career.Playoffs.Goals != 1 {
+		t.Errorf("Expected one playoff game with a goal, got %+v", career.Playoffs)
+	}
+	if len(career.Seasons) != 3 {
+		t.Fatalf("Expected three season rows, got %d", len(career.Seasons))
+	}
+
+	first, second, playoffs := career.Seasons[0], career.Seasons[1], career.Seasons[2]
+	if first.Season != 20232024 || first.GameType != models.RegularSeasonGame {
+		t.Errorf("Expected the unlabelled game in the 20232024 regular season, got %d/%d", first.Season, first.GameType)
+	}
+	if second.GamesPlayed != 2 || second.Team != "TOR" || second.TOIPerGame != 20 {
+		t.Errorf("Expected 2 games ending on TOR at 20 minutes, got %+v", second)
+	}
+	if playoffs.Season != 20242025 || playoffs.GameType != models.PlayoffGame {
+		t.Errorf("Expected the 20242025 playoffs last, got %d/%d", playoffs.Season, playoffs.GameType)
+	}
+}
+
+func TestApplyProjectionBaseline(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}}
+	projection := services.BuildPlayerProjection(modifiers, gameLog(20, 1, func(i int) string { return "20:00" }), 10)
+
+	// Forty scoreless prior games count as twenty, so the goal rate is halved
+	prior := gameLog(40, 0, func(i int) string { return "20:00" })
+	services.ApplyProjectionBaseline(modifiers, &projection, prior)
+
+	if projection.BaselineGames != 40 || projection.BaselineWeight != 0.5 {
+		t.Errorf("Expected 40 baseline games at half weight, got %d at %.3f", projection.BaselineGames, projection.BaselineWeight)
+	}
+	if projection.PointsPerGame != 1.5 || projection.RestOfSeasonPoints != 15 {
+		t.Errorf("Expected 1.5 points per game and 15 rest of season, got %.3f and %.2f", projection.PointsPerGame, projection.RestOfSeasonPoints)
+	}
+	for _, stat := range projection.Stats {
+		if stat.StatID == "1" && (stat.PerGame != 0.5 || stat.RestOfSeason != 5) {
+			t.Errorf("Expected 0.5 goals per game and 5 rest of season, got %+v", stat)
+		}
+		if stat.StatID == "14" && stat.PerGame != 3 {
+			t.Errorf("Expected shots, unchanged from prior seasons, to stay at 3 per game, got %+v", stat)
+		}
+	}
+
+	untouched := services.BuildPlayerProjection(modifiers, gameLog(20, 1, func(i int) string { return "20:00" }), 10)
+	services.ApplyProjectionBaseline(modifiers, &untouched, nil)
+	if untouched.PointsPerGame != 3 || untouched.BaselineGames != 0 || untouched.BaselineWeight != 0 {
+		t.Errorf("Expected no prior games to leave the projection alone, got %+v", untouched)
+	}
+
+	// The baseline fades as the current sample grows: 20 prior games against 60 current ones
+	established := services.BuildPlayerProjection(modifiers, gameLog(60, 1, func(i int) string { return "20:00" }), 10)
+	services.ApplyProjectionBaseline(modifiers, &established, prior)
+	if established.BaselineWeight != 0.25 || established.PointsPerGame != 2.25 {
+		t.Errorf("Expected weight 0.25 and 2.25 points per game after 60 games, got %.3f and %.3f", established.BaselineWeight, established.PointsPerGame)
+	}
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestComputeCategoryAnalysis(t *testing.T) {
+	teamNames := map[string]string{
+		"453.l.1.t.1": "Alpha",
+		"453.l.1.t.2": "Bravo",
+		"453.l.1.t.3": "Charlie",
+	}
+
+	// Alpha's result in each category, one letter per week; Bravo gets the opposite
+	results := map[string]string{
+		"1":  "WWWWWWWWWWWWWLLLLLLL", // 13 of 20 sits exactly on the dominant line, with a losing run to finish
+		"2":  "WWWWWWWWWWWWLLLLLLLT", // 12.5 of 20 falls just short
+		"4":  "WLWLWLWLWLWLWLWLWLWL", // A slightly worse recent stretch stays steady
+		"14": "WW",                   // Too few decisions to label
+	}
+
+	var matchups []*models.Matchup
+	var statWinners []*models.StatWinnerWeeklyMatchup
+	for week := 20; week >= 1; week-- {
+		matchupKey := "m" + strconv.Itoa(week)
+		matchups = append(matchups, &models.Matchup{MatchupKey: matchupKey, Week: strconv.Itoa(week), WinningTeam: "453.l.1.t.1", LosingTeam: "453.l.1.t.2"})
+		for statID, weeks := range results {
+			if week > len(weeks) {
+				continue
+			}
+			statWinner := &models.StatWinnerWeeklyMatchup{Week: strconv.Itoa(week), MatchupKey: matchupKey, StatID: statID}
+			switch weeks[week-1] {
+			case 'W':
+				statWinner.WinningTeamKey = "453.l.1.t.1"
+			case 'L':
+				statWinner.WinningTeamKey = "453.l.1.t.2"
+			default:
+				statWinner.IsTied = true
+			}
+			statWinners = append(statWinners, statWinner)
+		}
+	}
+	// Results for matchups that were never stored are ignored
+	statWinners = append(statWinners, &models.StatWinnerWeeklyMatchup{Week: "1", MatchupKey: "unknown", StatID: "1", WinningTeamKey: "453.l.1.t.3"})
+
+	analysis := services.ComputeCategoryAnalysis(teamNames, matchups, statWinners)
+	if len(analysis) != 3 {
+		t.Fatalf("Expected 3 teams, got %d", len(analysis))
+	}
+
+	teams := make(map[string]models.TeamCategoryAnalysis)
+	for _, team := range analysis {
+		teams[team.TeamKey] = team
+	}
+	if analysis[0].TeamKey != "453.l.1.t.1" || analysis[2].TeamKey != "453.l.1.t.3" {
+		t.Errorf("Expected teams ordered by category wins, got %s, %s, %s", analysis[0].TeamKey, analysis[1].TeamKey, analysis[2].TeamKey)
+	}
+
+	alpha := teams["453.l.1.t.1"]
+	if alpha.Wins != 37 || alpha.Losses != 24 || alpha.Ties != 1 {
+		t.Errorf("Expected Alpha 37-24-1, got %d-%d-%d", alpha.Wins, alpha.Losses, alpha.Ties)
+	}
+	if !reflect.DeepEqual(alpha.Dominant, []string{"Goals"}) || len(alpha.Punted) != 0 {
+		t.Errorf("Expected Alpha to dominate goals only, got dominant %q punted %q", alpha.Dominant, alpha.Punted)
+	}
+
+	bravo := teams["453.l.1.t.2"]
+	if !reflect.DeepEqual(bravo.Punted, []string{"Goals"}) || len(bravo.Dominant) != 0 {
+		t.Errorf("Expected Bravo to punt goals only, got dominant %q punted %q", bravo.Dominant, bravo.Punted)
+	}
+
+	charlie := teams["453.l.1.t.3"]
+	if charlie.Name != "Charlie" || len(charlie.Categories) != 0 {
+		t.Errorf("Expected Charlie with no categories, got %+v", charlie)
+	}
+
+	tests := []struct {
+		teamKey   string
+		statID    string
+		winPct    float64
+		recentPct float64
+		trend     string
+	}{
+		{"453.l.1.t.1", "1", 0.65, 0, "declining"},
+		{"453.l.1.t.2", "1", 0.35, 1, "improving"},
+		{"453.l.1.t.1", "2", 0.625, 0.167, "declining"},
+		{"453.l.1.t.1", "4", 0.5, 0.333, "steady"},
+		{"453.l.1.t.1", "14", 1, 1, "steady"},
+		{"453.l.1.t.2", "14", 0, 0, "steady"},
+	}
+
+	for _, tc := range tests {
+		var record *models.CategoryRecord
+		for i := range teams[tc.teamKey].Categories {
+			if teams[tc.teamKey].Categories[i].StatID == tc.statID {
+				record = &teams[tc.teamKey].Categories[i]
+			}
+		}
+		if record == nil {
+			t.Errorf("%s: missing stat %s", tc.teamKey, tc.statID)
+			continue
+		}
+		if record.WinPercentage != tc.winPct || record.RecentWinPercentage != tc.recentPct || record.Trend != tc.trend {
+			t.Errorf("%s stat %s: expected %.3f, recent %.3f and %s, got %.3f, recent %.3f and %s",
+				tc.teamKey, tc.statID, tc.winPct, tc.recentPct, tc.trend, record.WinPercentage, record.RecentWinPercentage, record.Trend)
+		}
+		for i := 1; i < len(record.Results); i++ {
+			if record.Results[i-1].Week > record.Results[i].Week {
+				t.Errorf("%s stat %s: expected results in week order, got %+v", tc.teamKey, tc.statID, record.Results)
+				break
+			}
+		}
+	}
+
+	// Categories are listed best first
+	for i := 1; i < len(alpha.Categories); i++ {
+		if alpha.Categories[i-1].WinPercentage < alpha.Categories[i].WinPercentage {
+			t.Errorf("Expected categories sorted by win percentage, got %+v", alpha.Categories)
+			break
+		}
+	}
+}
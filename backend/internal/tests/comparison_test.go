@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestNormalizeRow(t *testing.T) {
+	tests := []struct {
+		name          string
+		lowerIsBetter bool
+		values        map[string]float64
+		leader        string
+		normalized    map[string]float64
+	}{
+		{
+			name:       "higher is better",
+			values:     map[string]float64{"a": 50, "b": 100, "c": 25},
+			leader:     "b",
+			normalized: map[string]float64{"a": 0.5, "b": 1, "c": 0.25},
+		},
+		{
+			name:          "lower is better",
+			lowerIsBetter: true,
+			values:        map[string]float64{"a": 10, "b": 40},
+			leader:        "a",
+			normalized:    map[string]float64{"a": 1, "b": 0.25},
+		},
+		{
+			name:       "level players have no leader",
+			values:     map[string]float64{"a": 3, "b": 3},
+			leader:     "",
+			normalized: map[string]float64{"a": 1, "b": 1},
+		},
+		{
+			name:       "tied leaders break on player key",
+			values:     map[string]float64{"b": 8, "a": 8, "c": 2},
+			leader:     "a",
+			normalized: map[string]float64{"a": 1, "b": 1, "c": 0.25},
+		},
+		{
+			name:       "negative values only credit the leader",
+			values:     map[string]float64{"a": -2, "b": -6},
+			leader:     "a",
+			normalized: map[string]float64{"a": 1, "b": 0},
+		},
+		{
+			name:       "single player leads",
+			values:     map[string]float64{"a": 0},
+			leader:     "a",
+			normalized: map[string]float64{"a": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := models.ComparisonRow{LowerIsBetter: tt.lowerIsBetter, Values: tt.values, Normalized: map[string]float64{}}
+			services.NormalizeRow(&row)
+
+			if row.Leader != tt.leader {
+				t.Errorf("Expected leader %q, got %q", tt.leader, row.Leader)
+			}
+			for playerKey, expected := range tt.normalized {
+				if row.Normalized[playerKey] != expected {
+					t.Errorf("Expected %s normalized to %.3f, got %.3f", playerKey, expected, row.Normalized[playerKey])
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleStrength(t *testing.T) {
+	games := []models.ScheduleGame{
+		{HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "MTL"},
+		{HomeTeamAbbrev: "BOS", AwayTeamAbbrev: "TOR"},
+		{HomeTeamAbbrev: "EDM", AwayTeamAbbrev: "CGY"},
+		{HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "SEA"},
+	}
+	pointPcts := map[string]float64{"MTL": 0.4, "BOS": 0.7, "SEA": 0.55, "EDM": 0.9}
+
+	tests := []struct {
+		name      string
+		team      string
+		pointPcts map[string]float64
+		count     int
+		average   float64
+	}{
+		{"averages each opponent", "TOR", pointPcts, 3, 0.55},
+		{"skips other teams' games", "CGY", pointPcts, 1, 0.9},
+		{"no games left", "VAN", pointPcts, 0, 0},
+		{"standings unavailable", "TOR", nil, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, average := services.ScheduleStrength(tt.team, games, tt.pointPcts)
+			if count != tt.count || average != tt.average {
+				t.Errorf("Expected %d games at %.3f, got %d at %.3f", tt.count, tt.average, count, average)
+			}
+		})
+	}
+}
+
+func TestBuildComparisonRows(t *testing.T) {
+	players := []models.ComparedPlayer{
+		{
+			PlayerKey: "a", TotalPoints: 120, PointsPerGame: 2.4, RecentAverage: 1.5, RemainingGames: 20, OpponentPointPct: 0.5,
+			Ranks: []models.PlayerRank{{RankType: "OR", RankValue: 10}, {RankType: "AR", RankValue: 30}},
+		},
+		{
+			PlayerKey: "b", TotalPoints: 90, PointsPerGame: 3, RecentAverage: 3, RemainingGames: 22, OpponentPointPct: 0,
+			Ranks: []models.PlayerRank{{RankType: "OR", RankValue: 5}},
+		},
+	}
+	statModifiers := []models.StatModifier{{StatID: "1", Value: 3, StatName: "Goals"}, {StatID: "5", Value: -0.5}}
+	statPoints := map[string]map[string]float64{
+		"a": {"1": 60, "5": -4},
+		"b": {"1": 45, "5": -1},
+	}
+
+	rows := services.BuildComparisonRows(players, statModifiers, statPoints)
+
+	byMetric := make(map[string]models.ComparisonRow)
+	var metrics []string
+	for _, row := range rows {
+		byMetric[row.Metric] = row
+		metrics = append(metrics, row.Metric)
+	}
+
+	want := []string{"Fantasy points", "Points per game", "Recent average", "Remaining games", "Opponent point %", "Goals points", "Penalty Minutes points", "Rank AR", "Rank OR"}
+	if len(metrics) != len(want) {
+		t.Fatalf("Expected rows %q, got %q", want, metrics)
+	}
+	for i, metric := range want {
+		if metrics[i] != metric {
+			t.Errorf("Expected row %d to be %q, got %q", i, metric, metrics[i])
+		}
+	}
+
+	leaders := map[string]string{
+		"Fantasy points":         "a",
+		"Points per game":        "b",
+		"Recent average":         "b",
+		"Remaining games":        "b",
+		"Opponent point %":       "a",
+		"Goals points":           "a",
+		"Penalty Minutes points": "b",
+		"Rank AR":                "a",
+		"Rank OR":                "b",
+	}
+	for metric, leader := range leaders {
+		if byMetric[metric].Leader != leader {
+			t.Errorf("Expected %s to lead %q, got %q", leader, metric, byMetric[metric].Leader)
+		}
+	}
+
+	// Players without standings or a rank are left out of the row rather than scored as zero
+	if _, ok := byMetric["Opponent point %"].Values["b"]; ok {
+		t.Error("Expected no opponent point percentage for b")
+	}
+	if _, ok := byMetric["Rank AR"].Values["b"]; ok {
+		t.Error("Expected no AR rank for b")
+	}
+	if row := byMetric["Goals points"]; row.StatID != "1" || row.Normalized["b"] != 0.75 {
+		t.Errorf("Expected goals points scaled to the leader, got %+v", row)
+	}
+	if row := byMetric["Rank OR"]; !row.LowerIsBetter || row.Normalized["a"] != 0.5 {
+		t.Errorf("Expected the better rank to lead, got %+v", row)
+	}
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestMapUserLeaguesAndTeams(t *testing.T) {
+	leaguesResponse := map[string]interface{}{
+		"users": map[string]interface{}{"user": map[string]interface{}{"games": map[string]interface{}{
+			"game": map[string]interface{}{
+				"leagues": map[string]interface{}{"league": []interface{}{
+					map[string]interface{}{"league_key": "465.l.1", "name": "Current", "game_code": "nhl", "season": "2025", "current_week": "3", "is_finished": "0", "end_date": "2026-04-12"},
+					map[string]interface{}{"league_key": "453.l.2", "name": "Old", "game_code": "nhl", "season": "2024", "is_finished": "1", "end_date": "2025-04-13"},
+				}},
+			},
+		}}},
+	}
+
+	leagues, err := services.MapUserLeagues(leaguesResponse)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(leagues) != 2 || leagues[0].CurrentWeek != 3 || !leagues[1].IsFinished {
+		t.Fatalf("Expected both leagues mapped from a single game, got %+v", leagues)
+	}
+	if !services.IsActiveLeague(leagues[0], "2025-10-28") || services.IsActiveLeague(leagues[1], "2025-10-28") {
+		t.Errorf("Expected only the current league to be active")
+	}
+	if services.IsActiveLeague(leagues[0], "2026-05-01") {
+		t.Errorf("Expected a league past its end date to be inactive")
+	}
+
+	teamsResponse := map[string]interface{}{
+		"users": map[string]interface{}{"user": map[string]interface{}{"games": map[string]interface{}{
+			"game": map[string]interface{}{
+				"teams": map[string]interface{}{"team": map[string]interface{}{"team_key": "465.l.1.t.4", "name": "Mine"}},
+			},
+		}}},
+	}
+
+	teams, err := services.MapUserTeams(teamsResponse)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(teams) != 1 || teams[0].LeagueId != "465.l.1" || teams[0].Name != "Mine" {
+		t.Errorf("Expected the team resolved to its league, got %+v", teams)
+	}
+}
+
+func TestMapTeamMatchup(t *testing.T) {
+	response := map[string]interface{}{
+		"team": map[string]interface{}{"matchups": map[string]interface{}{
+			"matchup": map[string]interface{}{
+				"week": "3", "status": "midevent", "week_start": "2025-10-20", "week_end": "2025-10-26",
+				"teams": map[string]interface{}{"team": []interface{}{
+					map[string]interface{}{"team_key": "t.2", "name": "Them", "team_points": map[string]interface{}{"total": "80.5"}, "team_projected_points": map[string]interface{}{"total": "150"}},
+					map[string]interface{}{"team_key": "t.1", "name": "Us", "team_points": map[string]interface{}{"total": "92"}},
+				}},
+			},
+		}},
+	}
+
+	matchup, err := services.MapTeamMatchup(response, "t.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if matchup.Week != 3 || matchup.Team.Points != 92 || matchup.Opponent.Name != "Them" || matchup.Opponent.ProjectedPoints != 150 {
+		t.Errorf("Expected the matchup from the team's side, got %+v", matchup)
+	}
+}
+
+func TestBuildLineupIssues(t *testing.T) {
+	positions := []models.RosterPosition{
+		{Position: "C", Count: 2},
+		{Position: "G", Count: 1},
+		{Position: "BN", Count: 2},
+		{Position: "IR", Count: 1},
+	}
+	players := []models.RosterPlayer{
+		{PlayerKey: "p1", Name: "Healthy", TeamAbbreviation: "TOR", SelectedPosition: "C"},
+		{PlayerKey: "p2", Name: "Hurt", TeamAbbreviation: "TOR", SelectedPosition: "G", Status: "O", InjuryNote: "Knee"},
+		{PlayerKey: "p3", Name: "Idle", TeamAbbreviation: "NJ", SelectedPosition: "BN"},
+		{PlayerKey: "p4", Name: "Stashed", TeamAbbreviation: "BOS", SelectedPosition: "IR", Status: "IR"},
+	}
+
+	issues := services.BuildLineupIssues(positions, players, map[string]int{"TOR": 1})
+	if len(issues) != 2 {
+		t.Fatalf("Expected an empty C slot and an injured goalie, got %+v", issues)
+	}
+	if issues[0].Type != models.LineupIssueEmptySlot || issues[0].Position != "C" {
+		t.Errorf("Expected the empty C slot first, got %+v", issues[0])
+	}
+	if issues[1].Type != models.LineupIssueInjuredStarter || issues[1].Detail != "Ruled out (O: Knee)" {
+		t.Errorf("Expected the injured goalie flagged, got %+v", issues[1])
+	}
+
+	players[0].TeamAbbreviation = "BOS"
+	issues = services.BuildLineupIssues(positions, players, map[string]int{"TOR": 1})
+	if len(issues) != 3 || issues[1].Type != models.LineupIssueNoGame || issues[1].PlayerKey != "p1" {
+		t.Errorf("Expected the starter without a game flagged, got %+v", issues)
+	}
+	if issues = services.BuildLineupIssues(positions, players, map[string]int{}); len(issues) != 2 {
+		t.Errorf("Expected no game issues on a day without games, got %+v", issues)
+	}
+}
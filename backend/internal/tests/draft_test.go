@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestBuildDraftAnalysis(t *testing.T) {
+	player := func(key, name, goals string) models.Player {
+		return models.Player{PlayerKey: key, Name: models.PlayerName{FullName: name}, Stats: []models.Stat{{StatID: "1", Value: goals}}}
+	}
+	players := []models.Player{
+		player("p1", "Reach", "5"),
+		player("p2", "Star", "25"),
+		player("p3", "Sleeper", "20"),
+		player("p4", "Depth", "2.5"),
+	}
+	picks := []models.DraftPick{
+		{Pick: 4, Round: 2, TeamKey: "t1", PlayerKey: "p4"},
+		{Pick: 1, Round: 1, TeamKey: "t1", PlayerKey: "p1"},
+		{Pick: 2, Round: 1, TeamKey: "t2", PlayerKey: "p2"},
+		{Pick: 3, Round: 2, TeamKey: "t2", PlayerKey: "p3"},
+	}
+	modifiers := []models.StatModifier{{StatID: "1", Value: 2}}
+
+	analysis := services.BuildDraftAnalysis(picks, players, modifiers, map[string]string{"t1": "One", "t2": "Two"}, 2)
+
+	if len(analysis.Picks) != 4 || analysis.Picks[0].PlayerName != "Reach" {
+		t.Fatalf("Expected picks in draft order, got %+v", analysis.Picks)
+	}
+	reach := analysis.Picks[0]
+	if reach.Points != 10 || reach.ValueRank != 3 || reach.ExpectedPoints != 50 || reach.Surplus != -40 || reach.Verdict != "bust" {
+		t.Errorf("Expected the first pick to be a 40 point bust, got %+v", reach)
+	}
+	if len(analysis.Busts) != 1 || len(analysis.Steals) != 0 {
+		t.Errorf("Expected a single bust and no steals, got busts %+v steals %+v", analysis.Busts, analysis.Steals)
+	}
+	if players[0].Stats[0].Value != "5" {
+		t.Errorf("Expected player stats to be left unmodified, got %s", players[0].Stats[0].Value)
+	}
+
+	if len(analysis.Managers) != 2 {
+		t.Fatalf("Expected two graded managers, got %+v", analysis.Managers)
+	}
+	two, one := analysis.Managers[0], analysis.Managers[1]
+	if two.TeamName != "Two" || two.Grade != "A" || two.Surplus != 40 || two.BestPick != "Sleeper" {
+		t.Errorf("Expected Two to grade an A led by the sleeper, got %+v", two)
+	}
+	if one.Grade != "F" || one.Busts != 1 || one.WorstPick != "Reach" {
+		t.Errorf("Expected One to grade an F after the reach, got %+v", one)
+	}
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+)
+
+func TestPlayerGameStatsUpsertOnGameAndPlayer(t *testing.T) {
+	pool := useRecordingDB(t)
+
+	if err := repositories.DB.Migrator().CreateTable(&models.PlayerGameStat{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pool.statements) == 0 || !strings.Contains(pool.statements[len(pool.statements)-1], "PRIMARY KEY (`game_id`,`player_id`)") {
+		t.Fatalf("Expected the table keyed on game and player, got %q", pool.statements)
+	}
+
+	pool.statements = nil
+	games := []*models.PlayerGameStat{{GameID: "2024020001", PlayerID: "8478402", Goals: 1}}
+	if err := repositories.SavePlayerGameStats(games); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pool.statements) != 1 || !strings.Contains(pool.statements[0], "ON DUPLICATE KEY UPDATE") {
+		t.Fatalf("Expected a single upsert, got %q", pool.statements)
+	}
+	if strings.Contains(pool.statements[0], "`game_id`=VALUES(`game_id`)") {
+		t.Errorf("Expected the key columns to be left out of the update, got %q", pool.statements[0])
+	}
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestGameLogStatsGoalie(t *testing.T) {
+	game := &models.PlayerGameStat{GamesStarted: 1, Decision: "W", ShotsAgainst: 30, GoalsAgainst: 2, Saves: 28, SavePctg: 0.9333, Shutouts: 0}
+
+	values := make(map[string]string)
+	for _, stat := range services.GameLogStats(game) {
+		values[stat.StatID] = stat.Value
+	}
+
+	want := map[string]string{"19": "1", "21": "1", "22": "2", "25": "28", "27": "0", "28": "0.933"}
+	for statID, value := range want {
+		if values[statID] != value {
+			t.Errorf("Expected stat %s to be %s, got %q", statID, value, values[statID])
+		}
+	}
+
+	// A loss records no win, and a skater line has no save percentage
+	game.Decision = "L"
+	skater := &models.PlayerGameStat{Goals: 1}
+	for _, stat := range services.GameLogStats(game) {
+		if stat.StatID == "19" && stat.Value != "0" {
+			t.Errorf("Expected no win on a loss, got %s", stat.Value)
+		}
+	}
+	for _, stat := range services.GameLogStats(skater) {
+		if stat.StatID == "28" {
+			t.Errorf("Expected no save percentage for a skater, got %s", stat.Value)
+		}
+	}
+}
+
+func TestBuildGoalieOutlook(t *testing.T) {
+	statModifiers := []models.StatModifier{{StatID: "19", Value: 5}, {StatID: "22", Value: -1}, {StatID: "25", Value: 0.2}, {StatID: "27", Value: 3}}
+
+	start := func(date string) *models.PlayerGameStat {
+		return &models.PlayerGameStat{GameDate: date, TeamAbbrev: "TOR", GamesStarted: 1, Decision: "W", ShotsAgainst: 30, GoalsAgainst: 2, Saves: 28, SavePctg: 0.933}
+	}
+	// Started on the 1st, then 7 of the last 10 team games, with a relief appearance on the 7th
+	// ending the earlier run
+	games := []*models.PlayerGameStat{
+		start("2024-10-01"), start("2024-10-04"), start("2024-10-06"),
+		{GameDate: "2024-10-07", TeamAbbrev: "TOR", Decision: "L", ShotsAgainst: 10, GoalsAgainst: 3, Saves: 7},
+		start("2024-10-08"), start("2024-10-09"), start("2024-10-10"), start("2024-10-11"), start("2024-10-12"),
+	}
+
+	var played []models.ScheduleGame
+	for day := 12; day >= 1; day-- {
+		played = append(played, models.ScheduleGame{GameDate: fmt.Sprintf("2024-10-%02d", day), HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "MTL"})
+	}
+	upcoming := []models.ScheduleGame{
+		{GameDate: "2024-10-16", HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "OTT"},
+		{GameDate: "2024-10-13", HomeTeamAbbrev: "BOS", AwayTeamAbbrev: "TOR"},
+		{GameDate: "2024-10-14", HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "BUF"},
+	}
+
+	outlook := services.BuildGoalieOutlook(statModifiers, "TOR", games, played, upcoming)
+
+	if outlook.GamesPlayed != 9 || outlook.Starts != 8 || outlook.Wins != 8 || outlook.Losses != 1 {
+		t.Errorf("Expected 9 games, 8 starts and an 8-1 record, got %+v", outlook)
+	}
+	if outlook.RecentTeamGames != 10 || outlook.RecentStarts != 7 || outlook.StartShare != 0.7 {
+		t.Errorf("Expected 7 of the last 10 team games started, got %d of %d (%.3f)", outlook.RecentStarts, outlook.RecentTeamGames, outlook.StartShare)
+	}
+	if outlook.ConsecutiveStart != 5 {
+		t.Errorf("Expected 5 consecutive starts, got %d", outlook.ConsecutiveStart)
+	}
+
+	// Every start scores 5 + 28*0.2 - 2 = 8.6
+	if math.Abs(outlook.PointsPerStart-8.6) > 0.01 {
+		t.Errorf("Expected 8.6 points per start, got %.2f", outlook.PointsPerStart)
+	}
+	for _, stat := range outlook.PerStart {
+		switch stat.StatID {
+		case "19", "21", "22", "25", "27", "28":
+		default:
+			t.Errorf("Expected only goalie stats per start, got %s", stat.StatID)
+		}
+		if stat.RestOfSeason != 0 {
+			t.Errorf("Expected no rest-of-season total per start, got %+v", stat)
+		}
+	}
+
+	// After starting the 12th, the back-to-back on the 13th likely goes to the backup, who is
+	// unlikely to play both halves, so the starter returns for the 14th
+	want := []struct {
+		date       string
+		opponent   string
+		backToBack bool
+		likelihood float64
+	}{
+		{"2024-10-13", "@ BOS", true, 0.7 * 0.4},
+		{"2024-10-14", "vs BUF", true, 0.7 + 0.3*0.5},
+		{"2024-10-16", "vs OTT", false, 0.7},
+	}
+	if len(outlook.Upcoming) != len(want) {
+		t.Fatalf("Expected %d upcoming games, got %+v", len(want), outlook.Upcoming)
+	}
+	expectedStarts := 0.0
+	for i, expected := range want {
+		game := outlook.Upcoming[i]
+		if game.Date != expected.date || game.Opponent != expected.opponent || game.BackToBack != expected.backToBack {
+			t.Errorf("Game %d: expected %s %s back-to-back %v, got %+v", i, expected.date, expected.opponent, expected.backToBack, game)
+		}
+		if math.Abs(game.StartLikelihood-expected.likelihood) > 0.001 {
+			t.Errorf("Game %d: expected start likelihood %.3f, got %.3f", i, expected.likelihood, game.StartLikelihood)
+		}
+		expectedStarts += expected.likelihood
+	}
+	if math.Abs(outlook.ExpectedStarts-expectedStarts) > 0.01 {
+		t.Errorf("Expected %.2f expected starts, got %.2f", expectedStarts, outlook.ExpectedStarts)
+	}
+	if math.Abs(outlook.ProjectedPoints-expectedStarts*8.6) > 0.05 {
+		t.Errorf("Expected %.2f projected points, got %.2f", expectedStarts*8.6, outlook.ProjectedPoints)
+	}
+}
+
+func TestBuildGoalieOutlookWithoutTeamGames(t *testing.T) {
+	outlook := services.BuildGoalieOutlook(nil, "TOR", nil, nil, []models.ScheduleGame{{GameDate: "2024-10-13", HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "MTL"}})
+
+	if outlook.StartShare != 0 || outlook.ConsecutiveStart != 0 || outlook.ExpectedStarts != 0 {
+		t.Errorf("Expected no start share without played games, got %+v", outlook)
+	}
+	if len(outlook.Upcoming) != 1 || outlook.Upcoming[0].BackToBack {
+		t.Errorf("Expected one upcoming game that is not a back-to-back, got %+v", outlook.Upcoming)
+	}
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestBuildLeagueHistory(t *testing.T) {
+	seasons := []models.LeagueSeason{
+		{LeagueKey: "453.l.1", Season: "2023", NextLeagueKey: "465.l.2", IsFinished: true, ChampionTeamKey: "453.l.1.t.1"},
+		{LeagueKey: "465.l.2", Season: "2024", PreviousLeagueKey: "453.l.1"},
+	}
+	teams := []models.SeasonTeam{
+		{LeagueKey: "465.l.2", TeamKey: "465.l.2.t.1", Season: "2024", Name: "Renamed", ManagerID: "m1", ManagerName: "Ace", Rank: 2, PlayoffSeed: 2, Wins: 2, Losses: 1},
+		{LeagueKey: "465.l.2", TeamKey: "465.l.2.t.2", Season: "2024", Name: "Bees", ManagerID: "m2", ManagerName: "Bee", Rank: 1, Wins: 3},
+		{LeagueKey: "465.l.2", TeamKey: "465.l.2.t.3", Season: "2024", Name: "Cats", ManagerID: "m3", ManagerName: "Cat", Rank: 3, Losses: 3},
+		{LeagueKey: "453.l.1", TeamKey: "453.l.1.t.1", Season: "2023", Name: "Original", ManagerID: "m1", ManagerName: "Ace", Rank: 1, PlayoffSeed: 1, Wins: 10, Losses: 4},
+		{LeagueKey: "453.l.1", TeamKey: "453.l.1.t.2", Season: "2023", Name: "Bees", ManagerID: "m2", ManagerName: "Bee", Rank: 2, PlayoffSeed: 2, Wins: 8, Losses: 6},
+		{LeagueKey: "453.l.1", TeamKey: "453.l.1.t.3", Season: "2023", Name: "Cats", ManagerID: "m3", ManagerName: "Cat", Rank: 3, Wins: 3, Losses: 11},
+	}
+	matchups := []models.SeasonMatchup{
+		{LeagueKey: "453.l.1", Week: 1, TeamKey: "453.l.1.t.1", OpponentKey: "453.l.1.t.2", TeamPoints: 100, OpponentPoints: 90, WinnerTeamKey: "453.l.1.t.1"},
+		{LeagueKey: "453.l.1", Week: 20, TeamKey: "453.l.1.t.2", OpponentKey: "453.l.1.t.1", TeamPoints: 110, OpponentPoints: 120, WinnerTeamKey: "453.l.1.t.1", IsPlayoffs: true},
+		{LeagueKey: "453.l.1", Week: 21, TeamKey: "453.l.1.t.3", OpponentKey: "453.l.1.t.1", TeamPoints: 90, OpponentPoints: 10, WinnerTeamKey: "453.l.1.t.3", IsPlayoffs: true, IsConsolation: true},
+		{LeagueKey: "465.l.2", Week: 1, TeamKey: "465.l.2.t.2", OpponentKey: "465.l.2.t.1", TeamPoints: 80, OpponentPoints: 70, WinnerTeamKey: "465.l.2.t.2"},
+		{LeagueKey: "465.l.2", Week: 2, TeamKey: "465.l.2.t.1", OpponentKey: "465.l.2.t.3", TeamPoints: 50, OpponentPoints: 50, IsTied: true},
+	}
+
+	history := services.BuildLeagueHistory(seasons, teams, matchups, "")
+
+	if len(history.Seasons) != 2 || history.Seasons[0].Season != "2024" || history.Seasons[1].ChampionManager != "Ace" {
+		t.Errorf("Expected seasons newest first with the 2023 champion, got %+v", history.Seasons)
+	}
+
+	if len(history.Managers) != 3 || history.Managers[0].ManagerID != "m1" {
+		t.Fatalf("Expected the champion listed first, got %+v", history.Managers)
+	}
+	ace := history.Managers[0]
+	if ace.Seasons != 2 || ace.Championships != 1 || ace.PlayoffAppearances != 1 || ace.Wins != 12 || ace.Losses != 5 || ace.WinPercentage != 0.706 {
+		t.Errorf("Expected the unfinished season to count toward the record only, got %+v", ace)
+	}
+	if ace.PlayoffWins != 1 || ace.PlayoffLosses != 0 || ace.BestFinish != 1 || ace.AverageFinish != 1 {
+		t.Errorf("Expected consolation games left out of the playoff record, got %+v", ace)
+	}
+	if len(ace.TeamNames) != 2 || ace.TeamNames[0] != "Original" || ace.TeamNames[1] != "Renamed" {
+		t.Errorf("Expected team names in season order, got %v", ace.TeamNames)
+	}
+
+	var aceBee *models.HeadToHeadRecord
+	for i, record := range history.HeadToHead {
+		if record.ManagerID == "m1" && record.OpponentID == "m2" {
+			aceBee = &history.HeadToHead[i]
+		}
+	}
+	if aceBee == nil || aceBee.Meetings != 3 || aceBee.Wins != 2 || aceBee.Losses != 1 || aceBee.PointsFor != 290 || aceBee.PlayoffMeetings != 1 {
+		t.Errorf("Expected Ace to lead Bee 2-1 across seasons, got %+v", aceBee)
+	}
+
+	filtered := services.BuildLeagueHistory(seasons, teams, matchups, "m3")
+	if len(filtered.HeadToHead) != 1 || filtered.HeadToHead[0].OpponentName != "Ace" || filtered.HeadToHead[0].Ties != 1 {
+		t.Errorf("Expected only Cat's tie with Ace, got %+v", filtered.HeadToHead)
+	}
+}
+
+func TestMapLeagueSeasonAndScoreboard(t *testing.T) {
+	season, err := services.MapLeagueSeason(map[string]interface{}{
+		"league": map[string]interface{}{"league_key": "465.l.2", "season": "2024", "renew": "453_1", "renewed": "", "is_finished": "0"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if season.PreviousLeagueKey != "453.l.1" || season.NextLeagueKey != "" || season.IsFinished {
+		t.Errorf("Expected the renew key converted to the previous league key, got %+v", season)
+	}
+
+	team := func(key, points string) map[string]interface{} {
+		return map[string]interface{}{"team_key": key, "team_points": map[string]interface{}{"total": points}}
+	}
+	matchups, err := services.MapScoreboardMatchups(map[string]interface{}{
+		"league": map[string]interface{}{"league_key": "465.l.2", "scoreboard": map[string]interface{}{"matchups": map[string]interface{}{
+			"matchup": []interface{}{
+				map[string]interface{}{"week": "4", "status": "postevent", "is_playoffs": "1", "winner_team_key": "t.2",
+					"teams": map[string]interface{}{"team": []interface{}{team("t.1", "70.5"), team("t.2", "81")}}},
+				map[string]interface{}{"week": "4", "status": "midevent",
+					"teams": map[string]interface{}{"team": []interface{}{team("t.3", "10"), team("t.4", "12")}}},
+			},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(matchups) != 1 || matchups[0].OpponentPoints != 81 || !matchups[0].IsPlayoffs || matchups[0].WinnerTeamKey != "t.2" {
+		t.Errorf("Expected only the decided matchup, got %+v", matchups)
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestOptimizeLineupForDay(t *testing.T) {
+	positions := []models.RosterPosition{
+		{Position: "C", Count: 1},
+		{Position: "LW", Count: 1},
+		{Position: "BN", Count: 3},
+		{Position: "IR", Count: 1},
+	}
+
+	players := []models.RosterPlayer{
+		{PlayerKey: "p1", Name: "Dual", TeamAbbreviation: "TOR", PositionType: "P", EligiblePositions: []string{"C", "LW"}, SelectedPosition: "C"},
+		{PlayerKey: "p2", Name: "Center", TeamAbbreviation: "TB", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "BN"},
+		{PlayerKey: "p3", Name: "Winger", TeamAbbreviation: "BOS", PositionType: "P", EligiblePositions: []string{"LW"}, SelectedPosition: "LW"},
+		{PlayerKey: "p4", Name: "Idle", TeamAbbreviation: "EDM", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "BN"},
+		{PlayerKey: "p5", Name: "Injured", TeamAbbreviation: "BOS", PositionType: "P", EligiblePositions: []string{"LW"}, SelectedPosition: "IR"},
+	}
+
+	perGame := map[string]float64{"p1": 3, "p2": 2.5, "p3": 2, "p4": 4, "p5": 5}
+	opponents := map[string]string{"TOR": "vs TBL", "TBL": "@ TOR", "BOS": "vs MTL", "MTL": "@ BOS"}
+
+	lineup := services.OptimizeLineupForDay("2024-11-01", positions, players, perGame, opponents)
+
+	starters := map[string]string{}
+	for _, slot := range lineup.Starters {
+		starters[slot.Position] = slot.PlayerKey
+	}
+	if starters["C"] != "p2" || starters["LW"] != "p1" {
+		t.Errorf("Expected Center at C and Dual at LW, got %v", starters)
+	}
+	if lineup.ProjectedPoints != 5.5 {
+		t.Errorf("Expected 5.5 projected points, got %.2f", lineup.ProjectedPoints)
+	}
+	if lineup.CurrentPoints != 5 {
+		t.Errorf("Expected 5 current points, got %.2f", lineup.CurrentPoints)
+	}
+	if len(lineup.Start) != 1 || lineup.Start[0] != "Center" || len(lineup.Sit) != 1 || lineup.Sit[0] != "Winger" {
+		t.Errorf("Expected to start Center and sit Winger, got start %v sit %v", lineup.Start, lineup.Sit)
+	}
+
+	reasons := map[string]string{}
+	for _, decision := range lineup.Bench {
+		reasons[decision.PlayerKey] = decision.Reason
+	}
+
+	tests := []struct {
+		playerKey string
+		reason    string
+	}{
+		{"p3", "Projects 2.00 points; Dual (3.00) is the weakest starter in an eligible slot (LW)"},
+		{"p4", "EDM has no game on 2024-11-01"},
+		{"p5", "Held in the IR slot"},
+	}
+
+	for _, tc := range tests {
+		if reasons[tc.playerKey] != tc.reason {
+			t.Errorf("%s: expected reason %q, got %q", tc.playerKey, tc.reason, reasons[tc.playerKey])
+		}
+	}
+}
+
+func TestOptimizeLineupForDaySkipsRuledOut(t *testing.T) {
+	positions := []models.RosterPosition{{Position: "C", Count: 1}, {Position: "BN", Count: 1}}
+	players := []models.RosterPlayer{
+		{PlayerKey: "p1", Name: "Out", TeamAbbreviation: "TOR", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "C", Status: "O", InjuryNote: "Illness"},
+		{PlayerKey: "p2", Name: "Healthy", TeamAbbreviation: "TOR", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "BN"},
+	}
+	perGame := map[string]float64{"p1": 4, "p2": 1}
+
+	lineup := services.OptimizeLineupForDay("2024-11-01", positions, players, perGame, map[string]string{"TOR": "vs TBL"})
+
+	if lineup.Starters[0].PlayerKey != "p2" || lineup.CurrentPoints != 0 {
+		t.Errorf("Expected Healthy to start over the ruled out starter, got %+v", lineup)
+	}
+	if len(lineup.Bench) != 1 || lineup.Bench[0].Reason != "Ruled out (O: Illness)" {
+		t.Errorf("Expected Out benched as ruled out, got %+v", lineup.Bench)
+	}
+}
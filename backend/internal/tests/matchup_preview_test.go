@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestProjectMatchupSideAddsRemainingStarts(t *testing.T) {
+	positions := []models.RosterPosition{
+		{Position: "C", Count: 1},
+		{Position: "BN", Count: 1},
+	}
+	players := []models.RosterPlayer{
+		{PlayerKey: "c1", TeamAbbreviation: "TOR", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "C"},
+		{PlayerKey: "c2", TeamAbbreviation: "BOS", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "BN"},
+	}
+	rates := map[string]services.PlayerRate{
+		"c1": {PointsPerGame: 3, Categories: map[string]float64{"1": 0.5}},
+		"c2": {PointsPerGame: 1, Categories: map[string]float64{"1": 1}},
+	}
+
+	// TOR plays twice, BOS three times, and only one center can start each night
+	from := time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 3)
+	opponents := map[string]map[string]string{
+		"2024-11-04": {"TOR": "vs MTL", "BOS": "@ NYR"},
+		"2024-11-05": {"BOS": "vs NYR"},
+		"2024-11-06": {"TOR": "@ OTT"},
+		"2024-11-07": {"BOS": "@ MTL"},
+	}
+	team := models.MatchupTeam{TeamKey: "453.l.1.t.1", Points: 10}
+
+	side := services.ProjectMatchupSide(team, positions, players, rates, nil, opponents, from, to)
+	if side.StartsPerPlayer["c1"] != 2 || side.StartsPerPlayer["c2"] != 2 || side.RemainingStarts != 4 {
+		t.Fatalf("Expected two starts each, got %v", side.StartsPerPlayer)
+	}
+	if side.RemainingPoints != 8 || side.ActualPoints != 10 {
+		t.Errorf("Expected 10 actual and 8 remaining points, got %.2f and %.2f", side.ActualPoints, side.RemainingPoints)
+	}
+	if side.PointsVariance != 20 {
+		t.Errorf("Expected points variance 20, got %.2f", side.PointsVariance)
+	}
+
+	// Categories count starts rather than points, so the optimizer still fills every night
+	team.Stats = []models.Stat{{StatID: "1", Value: "4"}}
+	categories := services.ProjectMatchupSide(team, positions, players, rates, []models.StatCategory{{StatID: "1"}}, opponents, from, to)
+	if categories.RemainingStarts != 4 || categories.ActualStats["1"] != 4 || categories.RemainingStats["1"] != 3 {
+		t.Errorf("Expected 4 goals scored and 3 projected over 4 starts, got %+v", categories)
+	}
+}
+
+func TestSimulateMatchupPoints(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	// A week that is over has no variance left
+	final := services.SimulateMatchup(services.SideProjection{ActualPoints: 80}, services.SideProjection{ActualPoints: 75}, nil, 100, rng)
+	if final.Win != 1 || final.Loss != 0 || final.Tie != 0 {
+		t.Errorf("Expected a certain win, got %+v", final)
+	}
+
+	team := services.SideProjection{ActualPoints: 40, RemainingPoints: 30, PointsVariance: 100}
+	opponent := services.SideProjection{ActualPoints: 35, RemainingPoints: 30, PointsVariance: 100}
+	result := services.SimulateMatchup(team, opponent, nil, 20000, rng)
+	// The five point lead is about a third of a standard deviation of the difference
+	if result.Win < 0.6 || result.Win > 0.7 {
+		t.Errorf("Expected a win probability near 0.64, got %.3f", result.Win)
+	}
+	if sum := result.Win + result.Tie + result.Loss; sum < 0.999 || sum > 1.001 {
+		t.Errorf("Expected probabilities to sum to one, got %.3f", sum)
+	}
+
+	even := services.SimulateMatchup(opponent, opponent, nil, 20000, rng)
+	if even.Win < 0.47 || even.Win > 0.53 {
+		t.Errorf("Expected an even matchup near 0.5, got %.3f", even.Win)
+	}
+}
+
+func TestSimulateMatchupCategories(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	categories := []models.StatCategory{{StatID: "1"}, {StatID: "22", LowerIsBetter: true}, {StatID: "28"}}
+
+	team := services.SideProjection{
+		ActualStats:    map[string]float64{"1": 20, "22": 5, "28": 0.92},
+		RemainingStats: map[string]float64{},
+	}
+	opponent := services.SideProjection{
+		ActualStats:    map[string]float64{"1": 10, "22": 9, "28": 0.92},
+		RemainingStats: map[string]float64{},
+	}
+
+	// More goals and fewer goals against win, and the tied save percentage splits
+	result := services.SimulateMatchup(team, opponent, categories, 50, rng)
+	if result.Win != 1 || result.CategoryWins["1"] != 1 || result.CategoryWins["22"] != 1 || result.CategoryWins["28"] != 0.5 {
+		t.Errorf("Expected a certain win with a tied save percentage, got %+v", result)
+	}
+	if result.ExpectedWins != 2 || result.ExpectedLosses != 0 {
+		t.Errorf("Expected two category wins and no losses, got %.2f and %.2f", result.ExpectedWins, result.ExpectedLosses)
+	}
+
+	// Remaining goals can swing the category, but rate stats never move
+	opponent.RemainingStats["1"] = 10
+	opponent.RemainingStats["28"] = 0.5
+	swing := services.SimulateMatchup(team, opponent, categories, 20000, rng)
+	if swing.CategoryWins["1"] < 0.45 || swing.CategoryWins["1"] > 0.55 {
+		t.Errorf("Expected goals near a coin flip, got %.3f", swing.CategoryWins["1"])
+	}
+	if swing.CategoryWins["28"] != 0.5 {
+		t.Errorf("Expected save percentage to stay tied, got %.3f", swing.CategoryWins["28"])
+	}
+}
+
+func TestScoredCategoriesFromLeagueSettings(t *testing.T) {
+	response := map[string]interface{}{
+		"league": map[string]interface{}{
+			"settings": map[string]interface{}{
+				"stat_categories": map[string]interface{}{
+					"stats": map[string]interface{}{
+						"stat": []interface{}{
+							map[string]interface{}{"stat_id": "1", "name": "Goals", "sort_order": "1", "position_type": "P"},
+							map[string]interface{}{"stat_id": "23", "name": "Goals Against Average", "sort_order": "0", "position_type": "G"},
+							map[string]interface{}{"stat_id": "22", "name": "Goals Against", "sort_order": "0", "position_type": "G", "is_only_display_stat": "1"},
+							map[string]interface{}{"stat_id": "26", "name": "Save Percentage", "sort_order": "1", "position_type": "G"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	league, err := services.MapToLeague(response)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(league.StatCategories) != 4 || !league.StatCategories[1].LowerIsBetter || !league.StatCategories[2].IsOnlyDisplayStat {
+		t.Fatalf("Expected four categories with sort order and display flags, got %+v", league.StatCategories)
+	}
+
+	// Display-only goals against is dropped and the rest keep settings order
+	categories := services.ScoredCategories(league)
+	want := []string{"1", "23", "26"}
+	if len(categories) != len(want) {
+		t.Fatalf("Expected %d scored categories, got %+v", len(want), categories)
+	}
+	for i, statID := range want {
+		if categories[i].StatID != statID {
+			t.Errorf("Expected category %d to be %s, got %s", i, statID, categories[i].StatID)
+		}
+	}
+	if !categories[1].LowerIsBetter || categories[2].LowerIsBetter {
+		t.Errorf("Expected only goals against average to be lower-is-better, got %+v", categories)
+	}
+
+	// Points leagues score no categories
+	league.StatModifiers = []models.StatModifier{{StatID: "1", Value: 3}}
+	if categories := services.ScoredCategories(league); len(categories) != 0 {
+		t.Errorf("Expected no categories in a points league, got %+v", categories)
+	}
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+var mockDraftPositions = []models.RosterPosition{
+	{Position: "C", Count: 1, IsStartingPosition: true},
+	{Position: "D", Count: 1, IsStartingPosition: true},
+	{Position: "G", Count: 1, IsStartingPosition: true},
+	{Position: "Util", Count: 1, IsStartingPosition: true},
+	{Position: "BN", Count: 1},
+	{Position: "IR", Count: 2},
+}
+
+func draftPlayer(key, position string, points float64) models.MockDraftPlayer {
+	positionType := "P"
+	if position == "G" {
+		positionType = "G"
+	}
+	return models.MockDraftPlayer{PlayerKey: key, Name: key, EligiblePositions: []string{position}, PositionType: positionType, ProjectedPoints: points}
+}
+
+func TestSnakeDraftSlot(t *testing.T) {
+	cases := []struct{ pick, round, slot int }{{1, 1, 1}, {4, 1, 4}, {5, 2, 4}, {8, 2, 1}, {9, 3, 1}}
+	for _, c := range cases {
+		if round, slot := services.SnakeDraftSlot(c.pick, 4); round != c.round || slot != c.slot {
+			t.Errorf("Pick %d: expected round %d slot %d, got round %d slot %d", c.pick, c.round, c.slot, round, slot)
+		}
+	}
+}
+
+func TestOpenRosterSlots(t *testing.T) {
+	roster := []models.MockDraftPlayer{draftPlayer("c1", "C", 200), draftPlayer("c2", "C", 150), draftPlayer("c3", "C", 100)}
+
+	open := services.OpenRosterSlots(mockDraftPositions, roster)
+	if len(open) != 2 || open["D"] != 1 || open["G"] != 1 {
+		t.Errorf("Expected centers to fill C, Util and the bench leaving D and G, got %v", open)
+	}
+}
+
+func TestRecommendDraftPicks(t *testing.T) {
+	available := services.RankDraftPlayers([]models.MockDraftPlayer{
+		draftPlayer("c2", "C", 190),
+		draftPlayer("c3", "C", 185),
+		draftPlayer("d1", "D", 170),
+		draftPlayer("d2", "D", 100),
+		draftPlayer("g1", "G", 120),
+		draftPlayer("g2", "G", 115),
+	})
+	roster := []models.MockDraftPlayer{draftPlayer("c1", "C", 200)}
+
+	recommendations := services.RecommendDraftPicks(mockDraftPositions, available, roster, 2, 3)
+	if len(recommendations) != 3 {
+		t.Fatalf("Expected three recommendations, got %+v", recommendations)
+	}
+	// Both centers and the top defenseman are gone by the next pick, leaving d2 for Util and D
+	top := recommendations[0]
+	if top.PlayerKey != "c2" || top.Slot != "Util" || top.DropOff != 90 || top.Score != 235 {
+		t.Errorf("Expected the best center at Util first, got %+v", top)
+	}
+	if defense := recommendations[2]; defense.PlayerKey != "d1" || defense.Slot != "D" || defense.DropOff != 70 || defense.Score != 205 {
+		t.Errorf("Expected the defenseman third with a 70 point drop-off, got %+v", defense)
+	}
+	for _, recommendation := range recommendations {
+		if recommendation.Slot == "C" {
+			t.Errorf("Expected the filled C slot to be skipped, got %+v", recommendation)
+		}
+	}
+}
+
+func TestBuildMockDraftState(t *testing.T) {
+	players := services.RankDraftPlayers([]models.MockDraftPlayer{
+		draftPlayer("c1", "C", 200),
+		draftPlayer("d1", "D", 170),
+		draftPlayer("g1", "G", 120),
+		draftPlayer("c2", "C", 110),
+	})
+	draft := models.MockDraft{ID: 1, Teams: 2, Rounds: 2, DraftPosition: 2, RosterPositions: mockDraftPositions}
+	picks := []models.MockDraftPick{{Pick: 1, Round: 1, TeamSlot: 1, PlayerKey: "c1"}}
+
+	state := services.BuildMockDraftState(draft, players, picks)
+	if state.CurrentPick != 2 || state.TeamSlot != 2 || !state.IsUserPick || state.PicksUntilUser != 0 {
+		t.Errorf("Expected the user on the clock at pick 2, got %+v", state)
+	}
+	if state.Picks[0].Name != "c1" || len(state.UserRoster) != 0 {
+		t.Errorf("Expected pick names filled in and an empty user roster, got %+v", state)
+	}
+	if len(state.Recommendations) != 3 || state.Recommendations[0].PlayerKey != "d1" {
+		t.Errorf("Expected the defenseman recommended first, got %+v", state.Recommendations)
+	}
+
+	picks = append(picks, models.MockDraftPick{Pick: 2, Round: 1, TeamSlot: 2, PlayerKey: "d1"},
+		models.MockDraftPick{Pick: 3, Round: 2, TeamSlot: 2, PlayerKey: "g1"},
+		models.MockDraftPick{Pick: 4, Round: 2, TeamSlot: 1, PlayerKey: "c2"})
+	state = services.BuildMockDraftState(draft, players, picks)
+	if !state.Complete || len(state.UserRoster) != 2 || len(state.Recommendations) != 0 {
+		t.Errorf("Expected a complete draft with two user picks, got %+v", state)
+	}
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/routes"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestOpenAPIRouteCoverage(t *testing.T) {
+	router := mux.NewRouter()
+	routes.RegisterRoutes(router)
+
+	missing, unused, err := services.OpenAPIRouteCoverage(router)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, route := range missing {
+		t.Errorf("Route %s has no OpenAPI entry in services/openapi_docs.go", route)
+	}
+
+	for _, route := range unused {
+		t.Errorf("OpenAPI entry %s does not match a registered route", route)
+	}
+}
+
+func TestOpenAPISpecEndpoint(t *testing.T) {
+	router := mux.NewRouter()
+	routes.RegisterRoutes(router)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", recorder.Code)
+	}
+
+	var spec struct {
+		OpenAPI    string                            `json:"openapi"`
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("Failed to decode spec: %v", err)
+	}
+
+	if spec.OpenAPI == "" {
+		t.Errorf("Expected openapi version to be set")
+	}
+
+	tests := []struct {
+		path   string
+		method string
+	}{
+		{"/api/v1/leagues/{leagueId}", "get"},
+		{"/api/v1/players/sync", "post"},
+		{"/api/v1/cache/{operation}", "delete"},
+		{"/get-league-info/{leagueId}", "get"},
+	}
+
+	for _, tc := range tests {
+		if _, ok := spec.Paths[tc.path][tc.method]; !ok {
+			t.Errorf("Expected %s %s in spec", tc.method, tc.path)
+		}
+	}
+
+	for _, schema := range []string{"CustomResponse", "League", "Player", "TeamMatchupResponse"} {
+		if _, ok := spec.Components.Schemas[schema]; !ok {
+			t.Errorf("Expected schema %s in components", schema)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/utils"
+)
+
+func TestYahooPlayerID(t *testing.T) {
+	tests := []struct {
+		playerKey string
+		want      string
+	}{
+		{"453.p.6743", "p.6743"},
+		{"465.p.6743", "p.6743"},
+		{"nhl.p.31", "p.31"},
+		{"p.6743", "p.6743"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := utils.YahooPlayerID(tt.playerKey); got != tt.want {
+			t.Errorf("YahooPlayerID(%q): expected %q, got %q", tt.playerKey, tt.want, got)
+		}
+	}
+}
+
+func TestPlayerIDMappingsMatchAcrossSeasons(t *testing.T) {
+	pool := useRecordingDB(t)
+
+	// The recording pool cannot return rows, so only the queries are checked
+	_, _ = repositories.GetNHLPlayerID("465.p.6743")
+	_, _ = repositories.GetPlayerIDMappingsByYahooIDs([]string{"465.p.6743", "453.p.31"})
+	if len(pool.statements) != 2 {
+		t.Fatalf("Expected two queries, got %q", pool.statements)
+	}
+
+	for i, statement := range pool.statements {
+		if !strings.Contains(statement, "SUBSTRING_INDEX(yahoo_player_id, '.', -2)") {
+			t.Errorf("Expected query %d to match on the key without its game, got %q", i, statement)
+		}
+	}
+	if len(pool.args[0]) == 0 || pool.args[0][0] != "p.6743" {
+		t.Errorf("Expected the single lookup to use p.6743, got %v", pool.args[0])
+	}
+	if !reflect.DeepEqual(pool.args[1], []interface{}{"p.6743", "p.31"}) {
+		t.Errorf("Expected the batch lookup to use both keys without their game, got %v", pool.args[1])
+	}
+}
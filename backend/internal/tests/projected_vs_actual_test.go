@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestBuildProjectedVsActual(t *testing.T) {
+	weeklyStats := map[int]map[string]string{
+		1: {"expectedPoints": "100", "finalPoints": "110"},
+		2: {"expectedPoints": "100", "finalPoints": "95"},
+		3: {"expectedPoints": "90", "finalPoints": "40"},
+	}
+
+	rosters := map[int][]models.RosterPlayer{
+		1: {
+			{PlayerKey: "453.p.1", Name: "Skater One", SelectedPosition: "C", Points: 60},
+			{PlayerKey: "453.p.2", Name: "Skater Two", SelectedPosition: "BN", Points: 20},
+			{PlayerKey: "453.p.3", Name: "Skater Three", SelectedPosition: "D", Points: 50},
+		},
+		2: {
+			{PlayerKey: "453.p.1", Name: "Skater One", SelectedPosition: "C", Points: 45},
+			{PlayerKey: "453.p.3", Name: "Skater Three", SelectedPosition: "D", Points: 50},
+		},
+	}
+
+	result := services.BuildProjectedVsActual("453.l.1.t.1", 3, weeklyStats, rosters)
+
+	if len(result.Weeks) != 3 {
+		t.Fatalf("Expected 3 weeks, got %d", len(result.Weeks))
+	}
+
+	// Week 3 is in progress and left out of the totals
+	if !result.Weeks[2].InProgress {
+		t.Errorf("Expected week 3 to be in progress")
+	}
+	if result.TotalProjected != 200 || result.TotalActual != 205 || result.TotalDelta != 5 {
+		t.Errorf("Unexpected totals: projected %.2f actual %.2f delta %.2f", result.TotalProjected, result.TotalActual, result.TotalDelta)
+	}
+	if result.WeeksOver != 1 || result.WeeksUnder != 1 {
+		t.Errorf("Expected 1 week over and 1 under, got %d and %d", result.WeeksOver, result.WeeksUnder)
+	}
+	if result.Weeks[1].CumulativeDelta != 5 {
+		t.Errorf("Expected cumulative delta 5 after week 2, got %.2f", result.Weeks[1].CumulativeDelta)
+	}
+
+	// Bench players do not contribute
+	if len(result.Weeks[0].Players) != 2 {
+		t.Errorf("Expected 2 contributing players in week 1, got %d", len(result.Weeks[0].Players))
+	}
+
+	if len(result.SeasonPlayers) != 2 || result.SeasonPlayers[0].PlayerKey != "453.p.1" || result.SeasonPlayers[0].Points != 105 {
+		t.Errorf("Unexpected season contributions: %+v", result.SeasonPlayers)
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func gameLog(count int, goals int, toi func(i int) string) []*models.PlayerGameStat {
+	var games []*models.PlayerGameStat
+	for i := 0; i < count; i++ {
+		games = append(games, &models.PlayerGameStat{
+			GameDate:   fmt.Sprintf("2024-11-%02d", i+1),
+			TeamAbbrev: "TOR",
+			Goals:      goals,
+			Points:     goals,
+			Shots:      3,
+			TOI:        toi(i),
+		})
+	}
+	return games
+}
+
+func TestBuildPlayerProjection(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}, {StatID: "14", Value: 0.5}}
+
+	steady := services.BuildPlayerProjection(modifiers, gameLog(10, 1, func(i int) string { return "20:00" }), 10)
+	if steady.TOIFactor != 1 || steady.PowerPlayFactor != 1 {
+		t.Errorf("Expected neutral usage factors, got %.3f and %.3f", steady.TOIFactor, steady.PowerPlayFactor)
+	}
+	if steady.PointsPerGame != 4.5 || steady.RestOfSeasonPoints != 45 {
+		t.Errorf("Expected 4.5 points per game and 45 rest of season, got %.3f and %.2f", steady.PointsPerGame, steady.RestOfSeasonPoints)
+	}
+	if steady.Team != "TOR" || steady.GamesSampled != 10 {
+		t.Errorf("Expected 10 TOR games, got %d %s", steady.GamesSampled, steady.Team)
+	}
+
+	// Ice time jumps from 15 to 22.5 minutes over the last five games
+	promoted := services.BuildPlayerProjection(modifiers, gameLog(20, 1, func(i int) string {
+		if i >= 15 {
+			return "22:30"
+		}
+		return "15:00"
+	}), 10)
+	if promoted.TOIFactor <= 1 || promoted.TOIFactor > 1.2 {
+		t.Errorf("Expected a TOI factor above 1 and at most 1.2, got %.3f", promoted.TOIFactor)
+	}
+	if promoted.PointsPerGame <= steady.PointsPerGame {
+		t.Errorf("Expected more ice time to raise points per game above %.3f, got %.3f", steady.PointsPerGame, promoted.PointsPerGame)
+	}
+}
+
+func TestBacktestPlayer(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}}
+	games := gameLog(10, 1, func(i int) string { return "18:00" })
+	for _, game := range games[6:] {
+		game.Goals = 0
+		game.Points = 0
+	}
+
+	result, ok := services.BacktestPlayer(modifiers, games, "2024-11-07")
+	if !ok {
+		t.Fatal("Expected a backtest result")
+	}
+	if result.GamesBefore != 6 || result.GamesAfter != 4 {
+		t.Errorf("Expected 6 games before and 4 after, got %d and %d", result.GamesBefore, result.GamesAfter)
+	}
+	if result.ProjectedPoints != 12 || result.ActualPoints != 0 || result.Error != 12 {
+		t.Errorf("Expected 12 projected, 0 actual, error 12, got %+v", result)
+	}
+
+	if _, ok := services.BacktestPlayer(modifiers, games, "2024-11-03"); ok {
+		t.Error("Expected no result with fewer than five games before the cutoff")
+	}
+}
+
+func TestBuildPointsSeriesStreaks(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}}
+	games := gameLog(10, 1, func(i int) string { return "18:00" })
+	for _, game := range games[5:] {
+		game.Goals = 0
+	}
+
+	series := services.BuildPointsSeries(modifiers, games, 3)
+
+	if series.TotalPoints != 15 || series.SeasonAverage != 1.5 {
+		t.Errorf("Expected 15 total and 1.5 average, got %.2f and %.2f", series.TotalPoints, series.SeasonAverage)
+	}
+	if series.Games[5].RollingAverage != 2 || series.Games[0].Breakdown["Goals"] != 3 {
+		t.Errorf("Unexpected game points: %+v", series.Games[5])
+	}
+	if series.CurrentForm != "cold" {
+		t.Errorf("Expected cold current form, got %s", series.CurrentForm)
+	}
+
+	expected := []models.PointsStreak{
+		{Type: "hot", StartDate: "2024-11-03", EndDate: "2024-11-06", Games: 4, AveragePoints: 2.25},
+		{Type: "cold", StartDate: "2024-11-07", EndDate: "2024-11-10", Games: 4, AveragePoints: 0},
+	}
+	if len(series.Streaks) != len(expected) {
+		t.Fatalf("Expected %d streaks, got %+v", len(expected), series.Streaks)
+	}
+	for i, streak := range expected {
+		if series.Streaks[i] != streak {
+			t.Errorf("Streak %d: expected %+v, got %+v", i, streak, series.Streaks[i])
+		}
+	}
+}
+
+func TestGameLogStale(t *testing.T) {
+	lastCompleted := map[string]string{"TOR": "2024-11-20", "MTL": "2024-11-18"}
+	games := []*models.PlayerGameStat{
+		{GameDate: "2024-11-12", TeamAbbrev: "MTL"},
+		{GameDate: "2024-11-18", TeamAbbrev: "TOR"},
+		{GameDate: "2024-11-15", TeamAbbrev: "MTL"},
+	}
+
+	tests := []struct {
+		name          string
+		games         []*models.PlayerGameStat
+		lastCompleted map[string]string
+		stale         bool
+	}{
+		{"team has played since", games, lastCompleted, true},
+		{"caught up with the team", games, map[string]string{"TOR": "2024-11-18", "MTL": "2024-11-25"}, false},
+		{"latest game decides the team after a trade", games[:1], map[string]string{"MTL": "2024-11-12", "TOR": "2024-11-20"}, false},
+		{"no stored games", nil, lastCompleted, true},
+		{"no schedule stored", games, map[string]string{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if stale := services.GameLogStale(tt.games, tt.lastCompleted); stale != tt.stale {
+				t.Errorf("Expected stale %v, got %v", tt.stale, stale)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/repositories"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// recordingPool stands in for a MySQL connection and records every statement it is sent
+type recordingPool struct {
+	statements []string
+	args       [][]interface{}
+	committed  bool
+}
+
+type recordingResult struct{ rows int64 }
+
+func (r recordingResult) LastInsertId() (int64, error) { return 0, nil }
+func (r recordingResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+func (p *recordingPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (p *recordingPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.statements = append(p.statements, query)
+	p.args = append(p.args, args)
+	return recordingResult{rows: 1}, nil
+}
+
+func (p *recordingPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	p.statements = append(p.statements, query)
+	p.args = append(p.args, args)
+	return nil, errors.New("query not supported")
+}
+
+func (p *recordingPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	p.statements = append(p.statements, query)
+	p.args = append(p.args, args)
+	return nil
+}
+
+func (p *recordingPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &recordingTx{p}, nil
+}
+
+// recordingTx is a transaction on a recordingPool
+type recordingTx struct {
+	*recordingPool
+}
+
+func (tx *recordingTx) Commit() error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *recordingTx) Rollback() error {
+	return nil
+}
+
+func useRecordingDB(t *testing.T) *recordingPool {
+	pool := &recordingPool{}
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: pool, SkipInitializeWithVersion: true}), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("Failed to open recording database: %v", err)
+	}
+
+	previous := repositories.DB
+	repositories.DB = db
+	t.Cleanup(func() { repositories.DB = previous })
+	return pool
+}
+
+func TestRosterEntriesRoundTrip(t *testing.T) {
+	roster := &models.TeamRoster{
+		TeamKey:      "nhl.l.1.t.1",
+		CoverageType: "week",
+		Coverage:     "3",
+		Players: []models.RosterPlayer{
+			{PlayerKey: "p1", Name: "Skater", DisplayPosition: "C,LW", PositionType: "P", EligiblePositions: []string{"C", "LW"}, SelectedPosition: "C", IsStarting: true, Points: 12.5},
+			{PlayerKey: "p2", Name: "Goalie", PositionType: "G", EligiblePositions: []string{"G"}, SelectedPosition: "IR+", Status: "IR", InjuryNote: "Knee"},
+		},
+	}
+
+	entries := services.RosterEntriesFromRoster(roster)
+	if len(entries) != 2 {
+		t.Fatalf("Expected one entry per player, got %d", len(entries))
+	}
+	if entries[0].TeamKey != "nhl.l.1.t.1" || entries[0].CoverageType != "week" || entries[0].Coverage != "3" || entries[0].PlayerName != "Skater" {
+		t.Errorf("Expected the entry to carry the roster period, got %+v", entries[0])
+	}
+	if entries[1].Status != "IR" || entries[1].InjuryNote != "Knee" || entries[1].IsStarting {
+		t.Errorf("Expected the injured goalie on the bench, got %+v", entries[1])
+	}
+
+	rosters := services.RostersFromEntries(entries)
+	if len(rosters) != 1 {
+		t.Fatalf("Expected a single roster, got %d", len(rosters))
+	}
+	if rosters[0].Coverage != "3" || len(rosters[0].Players) != 2 {
+		t.Fatalf("Expected week 3 with two players, got %+v", rosters[0])
+	}
+	if player := rosters[0].Players[0]; player.PlayerKey != "p1" || !player.IsStarting || player.Points != 12.5 || len(player.EligiblePositions) != 2 {
+		t.Errorf("Expected the skater to round trip, got %+v", player)
+	}
+}
+
+func TestRostersFromEntriesGroupsByPeriod(t *testing.T) {
+	entries := []models.RosterEntry{
+		{TeamKey: "t1", CoverageType: "week", Coverage: "9", PlayerKey: "a"},
+		{TeamKey: "t1", CoverageType: "week", Coverage: "10", PlayerKey: "a"},
+		{TeamKey: "t1", CoverageType: "week", Coverage: "9", PlayerKey: "b"},
+		{TeamKey: "t1", CoverageType: "date", Coverage: "2024-11-01", PlayerKey: "a"},
+		{TeamKey: "t2", CoverageType: "week", Coverage: "9", PlayerKey: "c"},
+	}
+
+	rosters := services.RostersFromEntries(entries)
+	if len(rosters) != 4 {
+		t.Fatalf("Expected four rosters, got %d", len(rosters))
+	}
+
+	// Rosters keep the order periods first appear in
+	want := []struct {
+		team, coverage string
+		players        int
+	}{
+		{"t1", "9", 2},
+		{"t1", "10", 1},
+		{"t1", "2024-11-01", 1},
+		{"t2", "9", 1},
+	}
+	for i, expected := range want {
+		if rosters[i].TeamKey != expected.team || rosters[i].Coverage != expected.coverage || len(rosters[i].Players) != expected.players {
+			t.Errorf("Roster %d: expected %s %s with %d players, got %+v", i, expected.team, expected.coverage, expected.players, rosters[i])
+		}
+	}
+
+	if rosters := services.RostersFromEntries(nil); len(rosters) != 0 {
+		t.Errorf("Expected no rosters without entries, got %d", len(rosters))
+	}
+}
+
+func TestSaveRosterEntriesReplacesEachPeriod(t *testing.T) {
+	pool := useRecordingDB(t)
+
+	entries := []models.RosterEntry{
+		{TeamKey: "t1", CoverageType: "week", Coverage: "3", PlayerKey: "a"},
+		{TeamKey: "t1", CoverageType: "week", Coverage: "3", PlayerKey: "b"},
+		{TeamKey: "t1", CoverageType: "week", Coverage: "4", PlayerKey: "a"},
+	}
+	if err := repositories.SaveRosterEntries(entries); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// One delete per period, then a single insert, all in one committed transaction
+	if len(pool.statements) != 3 {
+		t.Fatalf("Expected two deletes and an insert, got %q", pool.statements)
+	}
+	for _, statement := range pool.statements[:2] {
+		if !strings.HasPrefix(statement, "DELETE FROM `roster_entries`") {
+			t.Errorf("Expected a delete before inserting, got %q", statement)
+		}
+	}
+	if !strings.HasPrefix(pool.statements[2], "INSERT INTO `roster_entries`") {
+		t.Errorf("Expected the insert last, got %q", pool.statements[2])
+	}
+	if !pool.committed {
+		t.Error("Expected the transaction to commit")
+	}
+
+	pool.statements = nil
+	if err := repositories.SaveRosterEntries(nil); err != nil || len(pool.statements) != 0 {
+		t.Errorf("Expected nothing to be written without entries, got %q (%v)", pool.statements, err)
+	}
+}
+
+func TestGetTeamRosterHistorySortsWeeksNumerically(t *testing.T) {
+	pool := useRecordingDB(t)
+
+	// The recording pool cannot return rows, so only the query is checked
+	_, _ = repositories.GetTeamRosterHistory("t1")
+	if len(pool.statements) != 1 {
+		t.Fatalf("Expected a single query, got %q", pool.statements)
+	}
+	if !strings.Contains(pool.statements[0], "CASE WHEN coverage_type = 'week' THEN CAST(coverage AS UNSIGNED) END ASC") {
+		t.Errorf("Expected weeks to sort numerically, got %q", pool.statements[0])
+	}
+}
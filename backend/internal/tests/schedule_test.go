@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestFantasyWeekDates(t *testing.T) {
+	// 2024-25: the opening week runs ten days and the 4 Nations break merges three weeks into one
+	response := map[string]interface{}{
+		"game": map[string]interface{}{
+			"game_key": "453",
+			"game_weeks": map[string]interface{}{"game_week": []interface{}{
+				map[string]interface{}{"week": "1", "start": "2024-10-04", "end": "2024-10-13"},
+				map[string]interface{}{"week": "2", "start": "2024-10-14", "end": "2024-10-20"},
+				map[string]interface{}{"week": "17", "start": "2025-02-03", "end": "2025-02-23"},
+				map[string]interface{}{"week": "18", "start": "2025-02-24", "end": "2025-03-02"},
+			}},
+		},
+	}
+
+	weeks, err := services.MapGameWeeks(response)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(weeks) != 4 {
+		t.Fatalf("Expected 4 game weeks, got %d", len(weeks))
+	}
+
+	tests := []struct {
+		week  int
+		start string
+		end   string
+	}{
+		{1, "2024-10-04", "2024-10-13"},
+		{2, "2024-10-14", "2024-10-20"},
+		{17, "2025-02-03", "2025-02-23"},
+		{18, "2025-02-24", "2025-03-02"},
+	}
+
+	for _, tc := range tests {
+		start, end, err := services.FantasyWeekDates(weeks, tc.week)
+		if err != nil {
+			t.Fatalf("Week %d: unexpected error %v", tc.week, err)
+		}
+		if start.Format("2006-01-02") != tc.start || end.Format("2006-01-02") != tc.end {
+			t.Errorf("Week %d: expected %s to %s, got %s to %s", tc.week, tc.start, tc.end, start.Format("2006-01-02"), end.Format("2006-01-02"))
+		}
+	}
+
+	if _, _, err := services.FantasyWeekDates(weeks, 30); err == nil {
+		t.Error("Expected an error for a week outside the season")
+	}
+
+	// A single week comes back as an object rather than a list
+	single := map[string]interface{}{"game": map[string]interface{}{
+		"game_weeks": map[string]interface{}{"game_week": map[string]interface{}{"week": "1", "start": "2024-10-04", "end": "2024-10-13"}},
+	}}
+	if weeks, err := services.MapGameWeeks(single); err != nil || len(weeks) != 1 {
+		t.Errorf("Expected one game week, got %v (%v)", weeks, err)
+	}
+
+	invalid := map[string]interface{}{"game": map[string]interface{}{
+		"game_weeks": map[string]interface{}{"game_week": map[string]interface{}{"week": "1", "start": "October 4", "end": "2024-10-13"}},
+	}}
+	if _, err := services.MapGameWeeks(invalid); err == nil {
+		t.Error("Expected an error for an unparseable week start")
+	}
+}
+
+func TestBuildTeamGamesRemaining(t *testing.T) {
+	positions := []models.RosterPosition{
+		{Position: "C", Count: 1},
+		{Position: "LW", Count: 1},
+		{Position: "BN", Count: 2},
+		{Position: "IR+", Count: 1},
+	}
+	players := []models.RosterPlayer{
+		{PlayerKey: "c1", Name: "Center One", TeamAbbreviation: "TOR", EligiblePositions: []string{"C"}, SelectedPosition: "C"},
+		{PlayerKey: "c2", Name: "Center Two", TeamAbbreviation: "BOS", EligiblePositions: []string{"C"}, SelectedPosition: "BN"},
+		{PlayerKey: "lw1", Name: "Wing One", TeamAbbreviation: "MTL", EligiblePositions: []string{"LW"}, SelectedPosition: "LW", Status: "O"},
+		{PlayerKey: "ir1", Name: "Injured", TeamAbbreviation: "TOR", EligiblePositions: []string{"LW"}, SelectedPosition: "IR+", Status: "IR"},
+	}
+	games := []models.ScheduleGame{
+		{GameDate: "2024-11-04", HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "BOS"},
+		{GameDate: "2024-11-05", HomeTeamAbbrev: "MTL", AwayTeamAbbrev: "NYR"},
+		{GameDate: "2024-11-06", HomeTeamAbbrev: "BOS", AwayTeamAbbrev: "OTT"},
+	}
+
+	from := time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC)
+	end := from.AddDate(0, 0, 2)
+	remaining := services.BuildTeamGamesRemaining("453.l.1.t.1", positions, players, from, end, games)
+
+	// Both centers play on the 4th but only one fits; the ruled out wing and the IR player never count
+	if remaining.Games != 3 || remaining.UsableGames != 2 || remaining.WastedGames != 1 {
+		t.Errorf("Expected 3 games, 2 usable and 1 wasted, got %d, %d and %d", remaining.Games, remaining.UsableGames, remaining.WastedGames)
+	}
+	if remaining.OpenSlots != 4 {
+		t.Errorf("Expected 4 open slot-days, got %d", remaining.OpenSlots)
+	}
+	if len(remaining.Days) != 3 || remaining.Days[0].Games != 2 || remaining.Days[0].Usable != 1 || remaining.Days[1].Games != 0 {
+		t.Errorf("Unexpected days %+v", remaining.Days)
+	}
+	if len(remaining.Players) != 3 || remaining.Players[0].PlayerKey != "c2" || remaining.Players[0].Games != 2 {
+		t.Errorf("Expected the backup center to lead with 2 games, got %+v", remaining.Players)
+	}
+}
+
+func TestDiffScheduleGame(t *testing.T) {
+	start := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	stored := &models.ScheduleGame{ID: 1, GameDate: "2025-01-09", StartTimeUTC: start, HomeTeamAbbrev: "MTL", AwayTeamAbbrev: "TOR", GameState: "FUT", ScheduleState: "OK"}
+
+	if changes := services.DiffScheduleGame(nil, stored, start); len(changes) != 0 {
+		t.Errorf("Expected no changes for a new game, got %d", len(changes))
+	}
+
+	postponed := *stored
+	postponed.ScheduleState = "PPD"
+	postponed.GameDate = "2025-02-20"
+	postponed.StartTimeUTC = start.AddDate(0, 0, 41)
+	changes := services.DiffScheduleGame(stored, &postponed, start)
+	if len(changes) != 2 || changes[0].ChangeType != models.ScheduleChangeRescheduled || changes[1].ChangeType != models.ScheduleChangeStatus {
+		t.Fatalf("Expected a reschedule and a status change, got %+v", changes)
+	}
+	if changes[0].GameDate != "2025-02-20" || changes[1].OldValue != "OK" || changes[1].NewValue != "PPD" {
+		t.Errorf("Expected the new date and OK to PPD, got %+v", changes)
+	}
+
+	final := *stored
+	final.GameState = "OFF"
+	final.HomeScore, final.AwayScore, final.LastPeriodType = 2, 3, "SO"
+	changes = services.DiffScheduleGame(stored, &final, start)
+	if len(changes) != 1 || changes[0].NewValue != "TOR 3 - 2 MTL (SO)" {
+		t.Errorf("Expected a final result of TOR 3 - 2 MTL (SO), got %+v", changes)
+	}
+
+	if changes := services.DiffScheduleGame(&final, &final, start); len(changes) != 0 {
+		t.Errorf("Expected a synced final game to report nothing new, got %d", len(changes))
+	}
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestMapBoxscorePlayers(t *testing.T) {
+	boxscore := map[string]interface{}{
+		"id":       float64(2024020500),
+		"gameDate": "2025-01-10",
+		"homeTeam": map[string]interface{}{"abbrev": "TOR"},
+		"awayTeam": map[string]interface{}{"abbrev": "MTL"},
+		"playerByGameStats": map[string]interface{}{
+			"homeTeam": map[string]interface{}{
+				"forwards": []interface{}{
+					map[string]interface{}{"playerId": float64(8479318), "goals": float64(1), "assists": float64(1), "points": float64(2), "powerPlayGoals": float64(1), "sog": float64(4), "toi": "19:30"},
+				},
+				"goalies": []interface{}{
+					map[string]interface{}{"playerId": float64(8475883), "starter": true, "decision": "W", "goalsAgainst": float64(0), "saveShotsAgainst": "28/28", "savePctg": float64(1)},
+				},
+			},
+		},
+	}
+
+	lines := services.MapBoxscorePlayers(boxscore)
+	skater, goalie := lines["8479318"], lines["8475883"]
+	if skater == nil || goalie == nil {
+		t.Fatalf("Expected a skater and a goalie line, got %v", lines)
+	}
+	if skater.TeamAbbrev != "TOR" || skater.GameID != "2024020500" || skater.Shots != 4 || skater.PowerPlayPoints != 1 {
+		t.Errorf("Unexpected skater line %+v", skater)
+	}
+	if goalie.Saves != 28 || goalie.ShotsAgainst != 28 || goalie.GamesStarted != 1 || goalie.Shutouts != 1 {
+		t.Errorf("Unexpected goalie line %+v", goalie)
+	}
+}
+
+func TestBuildTeamToday(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}, {StatID: "2", Value: 2}}
+	games := []*models.LiveGame{
+		{ID: 1, HomeTeamAbbrev: "TOR", AwayTeamAbbrev: "MTL", Status: models.GameStatusLive, Period: 2, HomeScore: 1, AwayScore: 2},
+		{ID: 2, HomeTeamAbbrev: "LAK", AwayTeamAbbrev: "SJS", Status: models.GameStatusScheduled},
+	}
+	players := []models.RosterPlayer{
+		{PlayerKey: "453.p.1", Name: "Live Starter", TeamAbbreviation: "Tor", IsStarting: true},
+		{PlayerKey: "453.p.2", Name: "Late Starter", TeamAbbreviation: "LA", IsStarting: true},
+		{PlayerKey: "453.p.3", Name: "Off Night", TeamAbbreviation: "EDM", IsStarting: true},
+		{PlayerKey: "453.p.4", Name: "Bench Visitor", TeamAbbreviation: "MON"},
+	}
+	nhlIds := map[string]string{"453.p.1": "100", "453.p.4": "400"}
+	lines := map[string]*models.PlayerGameStat{
+		"100": {Goals: 1, Assists: 1, Points: 2},
+		"400": {Goals: 2, Points: 2},
+	}
+
+	today := services.BuildTeamToday(modifiers, players, games, nhlIds, lines)
+	if len(today.Playing) != 3 || len(today.NotPlaying) != 1 || today.NotPlaying[0] != "Off Night" {
+		t.Fatalf("Expected three players playing and Off Night idle, got %+v", today)
+	}
+
+	live, visitor := today.Playing[0], today.Playing[2]
+	if live.Opponent != "vs MTL" || live.Score != "1-2" || live.FantasyPoints != 5 {
+		t.Errorf("Expected the home starter trailing 1-2 with 5 points, got %+v", live)
+	}
+	if visitor.Opponent != "@ TOR" || visitor.Score != "2-1" || visitor.FantasyPoints != 6 {
+		t.Errorf("Expected the visitor leading 2-1 with 6 points, got %+v", visitor)
+	}
+	if today.StarterPoints != 5 || today.StartersLive != 1 || today.StartersLeft != 1 {
+		t.Errorf("Expected 5 starter points with one starter live and one to come, got %+v", today)
+	}
+}
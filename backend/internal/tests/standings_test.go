@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestComputePowerRankings(t *testing.T) {
+	teamNames := map[string]string{
+		"453.l.1.t.1": "Alpha",
+		"453.l.1.t.2": "Bravo",
+		"453.l.1.t.3": "Charlie",
+		"453.l.1.t.4": "Delta",
+	}
+
+	matchups := []*models.Matchup{
+		{MatchupKey: "m1", Week: "1", WinningTeam: "453.l.1.t.1", LosingTeam: "453.l.1.t.2"},
+		{MatchupKey: "m2", Week: "1", WinningTeam: "453.l.1.t.3", LosingTeam: "453.l.1.t.4"},
+	}
+
+	weeklyPoints := []models.TeamWeeklyStats{
+		{Week: "1", TeamKey: "453.l.1.t.1", Points: 100},
+		{Week: "1", TeamKey: "453.l.1.t.2", Points: 80},
+		{Week: "1", TeamKey: "453.l.1.t.3", Points: 90},
+		{Week: "1", TeamKey: "453.l.1.t.4", Points: 70},
+	}
+
+	rankings := services.ComputePowerRankings(teamNames, matchups, weeklyPoints)
+	if len(rankings) != 4 {
+		t.Fatalf("Expected 4 rankings, got %d", len(rankings))
+	}
+
+	tests := []struct {
+		teamKey       string
+		rank          int
+		allPlayWins   int
+		pointsAgainst float64
+		sos           float64
+	}{
+		{"453.l.1.t.1", 1, 3, 80, 0.333},
+		{"453.l.1.t.3", 2, 2, 70, 0},
+		{"453.l.1.t.2", 3, 1, 100, 1},
+		{"453.l.1.t.4", 4, 0, 90, 0.667},
+	}
+
+	for i, tc := range tests {
+		ranking := rankings[i]
+		if ranking.TeamKey != tc.teamKey || ranking.Rank != tc.rank {
+			t.Errorf("Expected %s at rank %d, got %s at rank %d", tc.teamKey, tc.rank, ranking.TeamKey, ranking.Rank)
+		}
+		if ranking.AllPlayWins != tc.allPlayWins {
+			t.Errorf("%s: expected %d all-play wins, got %d", tc.teamKey, tc.allPlayWins, ranking.AllPlayWins)
+		}
+		if ranking.PointsAgainst != tc.pointsAgainst {
+			t.Errorf("%s: expected %.2f points against, got %.2f", tc.teamKey, tc.pointsAgainst, ranking.PointsAgainst)
+		}
+		if ranking.StrengthOfSchedule != tc.sos {
+			t.Errorf("%s: expected strength of schedule %.3f, got %.3f", tc.teamKey, tc.sos, ranking.StrengthOfSchedule)
+		}
+	}
+}
+
+func TestComputeLuckAnalysis(t *testing.T) {
+	matchups := []*models.Matchup{
+		{MatchupKey: "m1", Week: "1", WinningTeam: "453.l.1.t.1", LosingTeam: "453.l.1.t.2"},
+		{MatchupKey: "m2", Week: "1", WinningTeam: "453.l.1.t.3", LosingTeam: "453.l.1.t.4"},
+	}
+
+	// Bravo loses with the second best score while Charlie wins with the third best
+	weeklyPoints := []models.TeamWeeklyStats{
+		{Week: "1", TeamKey: "453.l.1.t.1", Points: 100},
+		{Week: "1", TeamKey: "453.l.1.t.2", Points: 90},
+		{Week: "1", TeamKey: "453.l.1.t.3", Points: 80},
+		{Week: "1", TeamKey: "453.l.1.t.4", Points: 70},
+	}
+
+	luck := services.ComputeLuckAnalysis(map[string]string{}, matchups, weeklyPoints)
+
+	expected := map[string]struct {
+		expectedWins float64
+		luckIndex    float64
+	}{
+		"453.l.1.t.1": {1, 0},
+		"453.l.1.t.2": {0.667, -0.667},
+		"453.l.1.t.3": {0.333, 0.667},
+		"453.l.1.t.4": {0, 0},
+	}
+
+	if len(luck) != len(expected) {
+		t.Fatalf("Expected %d teams, got %d", len(expected), len(luck))
+	}
+
+	if luck[0].TeamKey != "453.l.1.t.3" {
+		t.Errorf("Expected luckiest team 453.l.1.t.3, got %s", luck[0].TeamKey)
+	}
+
+	for _, team := range luck {
+		want := expected[team.TeamKey]
+		if team.ExpectedWins != want.expectedWins || team.LuckIndex != want.luckIndex {
+			t.Errorf("%s: expected %.3f expected wins and %.3f luck, got %.3f and %.3f",
+				team.TeamKey, want.expectedWins, want.luckIndex, team.ExpectedWins, team.LuckIndex)
+		}
+		if len(team.Weeks) != 1 {
+			t.Errorf("%s: expected 1 week, got %d", team.TeamKey, len(team.Weeks))
+		}
+	}
+}
+
+func TestStandingsCountTiesAsHalfAWin(t *testing.T) {
+	teamNames := map[string]string{
+		"453.l.1.t.1": "Alpha",
+		"453.l.1.t.2": "Bravo",
+		"453.l.1.t.3": "Charlie",
+		"453.l.1.t.4": "Delta",
+	}
+
+	matchups := []*models.Matchup{
+		{MatchupKey: "m1", Week: "1", WinningTeam: "453.l.1.t.1", LosingTeam: "453.l.1.t.2"},
+		{MatchupKey: "m2", Week: "1", WinningTeam: "453.l.1.t.3", LosingTeam: "453.l.1.t.4", IsTied: true},
+	}
+
+	weeklyPoints := []models.TeamWeeklyStats{
+		{Week: "1", TeamKey: "453.l.1.t.1", Points: 100},
+		{Week: "1", TeamKey: "453.l.1.t.2", Points: 90},
+		{Week: "1", TeamKey: "453.l.1.t.3", Points: 80},
+		{Week: "1", TeamKey: "453.l.1.t.4", Points: 80},
+	}
+
+	// Charlie and Delta each take half a win: 100 * (0.5*0.167 + 0.25*0.5 + 0.25*0.8)
+	rankings := services.ComputePowerRankings(teamNames, matchups, weeklyPoints)
+	for _, ranking := range rankings {
+		if ranking.TeamKey != "453.l.1.t.3" && ranking.TeamKey != "453.l.1.t.4" {
+			continue
+		}
+		if ranking.Wins != 0 || ranking.Losses != 0 || ranking.Ties != 1 {
+			t.Errorf("%s: expected a 0-0-1 record, got %d-%d-%d", ranking.TeamKey, ranking.Wins, ranking.Losses, ranking.Ties)
+		}
+		if ranking.PowerScore != 40.83 {
+			t.Errorf("%s: expected power score 40.83, got %.2f", ranking.TeamKey, ranking.PowerScore)
+		}
+		if ranking.PointsFor != 80 || ranking.PointsAgainst != 80 {
+			t.Errorf("%s: expected 80 points for and against, got %.2f and %.2f", ranking.TeamKey, ranking.PointsFor, ranking.PointsAgainst)
+		}
+	}
+	if rankings[2].TeamKey != "453.l.1.t.3" || rankings[3].TeamKey != "453.l.1.t.4" {
+		t.Errorf("Expected the tied teams last in key order, got %s and %s", rankings[2].TeamKey, rankings[3].TeamKey)
+	}
+
+	// A tie is worth half a win against an all-play share of 0.5 out of 3
+	luck := services.ComputeLuckAnalysis(teamNames, matchups, weeklyPoints)
+	for _, team := range luck {
+		if team.TeamKey != "453.l.1.t.3" && team.TeamKey != "453.l.1.t.4" {
+			continue
+		}
+		if team.Wins != 0 || team.Losses != 0 || team.Ties != 1 {
+			t.Errorf("%s: expected a 0-0-1 record, got %d-%d-%d", team.TeamKey, team.Wins, team.Losses, team.Ties)
+		}
+		if team.ExpectedWins != 0.167 || team.LuckIndex != 0.333 {
+			t.Errorf("%s: expected 0.167 expected wins and 0.333 luck, got %.3f and %.3f", team.TeamKey, team.ExpectedWins, team.LuckIndex)
+		}
+		if len(team.Weeks) != 1 || team.Weeks[0].Won || !team.Weeks[0].Tied || team.Weeks[0].Luck != 0.333 {
+			t.Errorf("%s: expected a tied week with 0.333 luck, got %+v", team.TeamKey, team.Weeks)
+		}
+	}
+}
+
+func TestMapTeamMatchupsKeepsTies(t *testing.T) {
+	matchup := func(week, winner, tied string) map[string]interface{} {
+		return map[string]interface{}{
+			"week":            week,
+			"winner_team_key": winner,
+			"is_tied":         tied,
+			"teams": map[string]interface{}{
+				"team": []interface{}{
+					map[string]interface{}{"team_key": "453.l.1.t.1"},
+					map[string]interface{}{"team_key": "453.l.1.t.2"},
+				},
+			},
+		}
+	}
+	data := map[string]interface{}{
+		"team": map[string]interface{}{
+			"matchups": map[string]interface{}{
+				"matchup": []interface{}{
+					matchup("1", "453.l.1.t.2", "0"),
+					matchup("2", "", "1"),
+					matchup("3", "", "0"), // Still in progress
+				},
+			},
+		},
+	}
+
+	matchups, _, _, err := services.MapTeamMatchups(data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(matchups) != 2 {
+		t.Fatalf("Expected the decided and tied matchups, got %d", len(matchups))
+	}
+	if matchups[0].WinningTeam != "453.l.1.t.2" || matchups[0].LosingTeam != "453.l.1.t.1" || matchups[0].IsTied {
+		t.Errorf("Expected Bravo to win week 1, got %+v", matchups[0])
+	}
+	if !matchups[1].IsTied || matchups[1].WinningTeam != "453.l.1.t.1" || matchups[1].LosingTeam != "453.l.1.t.2" {
+		t.Errorf("Expected a tie in week 2 with both teams kept, got %+v", matchups[1])
+	}
+}
+
+func TestMapLeagueScoreboard(t *testing.T) {
+	team := func(key, points string) map[string]interface{} {
+		return map[string]interface{}{
+			"team_key":    key,
+			"team_points": map[string]interface{}{"total": points},
+			"team_stats":  map[string]interface{}{"stats": map[string]interface{}{"stat": []interface{}{map[string]interface{}{"stat_id": "1", "value": "3"}}}},
+		}
+	}
+	data := map[string]interface{}{
+		"league": map[string]interface{}{"league_key": "453.l.1", "scoreboard": map[string]interface{}{"matchups": map[string]interface{}{
+			"matchup": []interface{}{
+				map[string]interface{}{"week": "2", "winner_team_key": "453.l.1.t.2",
+					"teams":        map[string]interface{}{"team": []interface{}{team("453.l.1.t.1", "70.5"), team("453.l.1.t.2", "81")}},
+					"stat_winners": map[string]interface{}{"stat_winner": []interface{}{map[string]interface{}{"stat_id": "1", "winner_team_key": "453.l.1.t.2"}}}},
+				map[string]interface{}{"week": "2", "is_tied": "0",
+					"teams": map[string]interface{}{"team": []interface{}{team("453.l.1.t.3", "10"), team("453.l.1.t.4", "12")}}},
+			},
+		}}},
+	}
+
+	matchups, teamStats, statWinners, err := services.MapLeagueScoreboard(data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(matchups) != 1 || matchups[0].WinningTeam != "453.l.1.t.2" || matchups[0].LosingTeam != "453.l.1.t.1" || matchups[0].Week != "2" {
+		t.Errorf("Expected only the decided matchup, got %+v", matchups)
+	}
+	if len(teamStats) != 2 || teamStats[1].Points != 81 || len(teamStats[1].Stats) != 1 {
+		t.Errorf("Expected weekly stats for both decided teams, got %+v", teamStats)
+	}
+	if len(statWinners) != 1 || statWinners[0].MatchupKey != matchups[0].MatchupKey {
+		t.Errorf("Expected the stat winner keyed on the matchup, got %+v", statWinners)
+	}
+}
+
+func TestUnsyncedMatchupWeeks(t *testing.T) {
+	endDate := time.Date(2025, 4, 13, 0, 0, 0, 0, time.UTC)
+	settings := &models.League{StartWeek: 1, CurrentWeek: 5, EndWeek: 25, EndDate: endDate}
+	stored := []*models.Matchup{{Week: "1"}, {Week: "3"}}
+
+	weeks := services.UnsyncedMatchupWeeks(settings, stored, time.Date(2024, 11, 10, 0, 0, 0, 0, time.UTC))
+	if !reflect.DeepEqual(weeks, []int{2, 4}) {
+		t.Errorf("Expected the completed weeks without matchups, got %v", weeks)
+	}
+
+	settings.CurrentWeek = 25
+	weeks = services.UnsyncedMatchupWeeks(settings, stored, endDate.AddDate(0, 0, 3))
+	if len(weeks) != 23 || weeks[len(weeks)-1] != 25 {
+		t.Errorf("Expected the final week once the season is over, got %v", weeks)
+	}
+}
@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestDiffPlayerStatuses(t *testing.T) {
+	injuredAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	stored := map[string]models.PlayerStatus{
+		"453.p.1": {PlayerKey: "453.p.1", Status: "DTD", StatusSince: injuredAt},
+		"453.p.2": {PlayerKey: "453.p.2", Status: "IR", StatusSince: injuredAt},
+	}
+	fetched := []models.PlayerStatus{
+		{PlayerKey: "453.p.1", Status: "DTD"},                         // Unchanged
+		{PlayerKey: "453.p.2", Status: ""},                            // Activated
+		{PlayerKey: "453.p.3", Status: ""},                            // New and healthy
+		{PlayerKey: "453.p.4", Status: "O", InjuryNote: "Upper Body"}, // New and already out
+		{PlayerKey: "453.p.4", Status: "O", InjuryNote: "Upper Body"}, // Seen twice in one fetch
+	}
+
+	statuses, changes := services.DiffPlayerStatuses(stored, fetched, now)
+	if len(statuses) != 4 {
+		t.Fatalf("Expected four statuses, got %d", len(statuses))
+	}
+	if !statuses[0].StatusSince.Equal(injuredAt) || !statuses[1].StatusSince.Equal(now) {
+		t.Errorf("Expected an unchanged status to keep its start and a change to restart it, got %v and %v", statuses[0].StatusSince, statuses[1].StatusSince)
+	}
+
+	if len(changes) != 2 {
+		t.Fatalf("Expected the activation and the new injury, got %+v", changes)
+	}
+	if changes[0].PlayerKey != "453.p.2" || changes[0].PreviousStatus != "IR" || changes[0].Status != "" {
+		t.Errorf("Expected 453.p.2 to go from IR to healthy, got %+v", changes[0])
+	}
+	if changes[1].PlayerKey != "453.p.4" || changes[1].Status != "O" || changes[1].InjuryNote != "Upper Body" {
+		t.Errorf("Expected 453.p.4 to be logged as out, got %+v", changes[1])
+	}
+}
+
+func TestBuildTeamInjuries(t *testing.T) {
+	roster := &models.TeamRoster{TeamKey: "453.l.1.t.1", Players: []models.RosterPlayer{
+		{PlayerKey: "a", Name: "Questionable", Status: "DTD", IsStarting: true},
+		{PlayerKey: "b", Name: "Healthy", IsStarting: true},
+		{PlayerKey: "c", Name: "Out Starter", Status: "O", IsStarting: true},
+		{PlayerKey: "d", Name: "Stashed", Status: "IR", SelectedPosition: "IR"},
+	}}
+
+	injuries := services.BuildTeamInjuries(roster, nil)
+	if len(injuries.Players) != 3 || injuries.RuledOutStarters != 1 {
+		t.Fatalf("Expected three injured players and one ruled out starter, got %+v", injuries)
+	}
+	if injuries.Players[0].Name != "Out Starter" || injuries.Players[2].Name != "Questionable" || injuries.Players[2].RuledOut {
+		t.Errorf("Expected ruled out players first and day-to-day last, got %+v", injuries.Players)
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestBuildTradeSideRosterFit(t *testing.T) {
+	positions := []models.RosterPosition{
+		{Position: "C", Count: 1},
+		{Position: "LW", Count: 1},
+		{Position: "BN", Count: 1},
+	}
+
+	roster := []models.RosterPlayer{
+		{PlayerKey: "c1", Name: "Center One", TeamAbbreviation: "TOR", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "C"},
+		{PlayerKey: "lw1", Name: "Wing One", TeamAbbreviation: "BOS", PositionType: "P", EligiblePositions: []string{"LW"}, SelectedPosition: "LW"},
+	}
+	incoming := []models.RosterPlayer{
+		{PlayerKey: "c2", Name: "Center Two", TeamAbbreviation: "BOS", PositionType: "P", EligiblePositions: []string{"C"}, SelectedPosition: "BN"},
+	}
+
+	rates := map[string]services.PlayerRate{
+		"c1":  {PointsPerGame: 2, Categories: map[string]float64{"1": 0.5}},
+		"lw1": {PointsPerGame: 1, Categories: map[string]float64{"1": 0.25}},
+		"c2":  {PointsPerGame: 3, Categories: map[string]float64{"1": 1}},
+	}
+
+	from := time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 6)
+	opponents := make(map[string]map[string]string)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		opponents[day.Format("2006-01-02")] = map[string]string{"TOR": "vs BOS", "BOS": "@ TOR"}
+	}
+
+	side := services.BuildTradeSide("453.l.1.t.1", positions, roster, incoming, []string{"lw1"}, rates, []string{"1"}, opponents, from, to)
+
+	if side.NetRestOfSeasonPoints != 14 {
+		t.Errorf("Expected net rest-of-season points 14, got %.2f", side.NetRestOfSeasonPoints)
+	}
+	// The new center pushes the old one to the bench and nobody can play LW
+	if side.WeeklyPointsBefore != 21 || side.WeeklyPointsAfter != 21 || side.WeeklyPointsChange != 0 {
+		t.Errorf("Expected weekly points 21 before and after, got %.2f and %.2f", side.WeeklyPointsBefore, side.WeeklyPointsAfter)
+	}
+	if side.OpenSlotsBefore != 0 || side.OpenSlotsAfter != 7 {
+		t.Errorf("Expected open slots 0 then 7, got %d then %d", side.OpenSlotsBefore, side.OpenSlotsAfter)
+	}
+	if side.ExcessPlayers != 0 {
+		t.Errorf("Expected no excess players, got %d", side.ExcessPlayers)
+	}
+	if len(side.Categories) != 1 || side.Categories[0].Before != 5.25 || side.Categories[0].After != 10.5 {
+		t.Errorf("Expected goals to go from 5.25 to 10.5, got %+v", side.Categories)
+	}
+}
+
+func TestProjectPlayerRate(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}, {StatID: "14", Value: 0.5}}
+	remaining := map[string]int{"TOR": 10}
+	player := models.RosterPlayer{PlayerKey: "465.p.1", TeamAbbreviation: "TOR"}
+	// 10 goals and 40 shots over 20 games: 50 points, 2.5 per game
+	season := &models.Player{
+		PlayerKey: "465.p.1",
+		Stats:     []models.Stat{{StatID: "0", Value: "20"}, {StatID: "1", Value: "10"}, {StatID: "14", Value: "40"}, {StatID: "15", Value: "25.0"}},
+	}
+
+	seasonRate := services.ProjectPlayerRate(modifiers, player, season, nil, remaining)
+	if seasonRate.Source != "season" || seasonRate.PointsPerGame != 2.5 {
+		t.Errorf("Expected the season rate of 2.5, got %+v", seasonRate)
+	}
+	if seasonRate.Categories["1"] != 0.5 || seasonRate.Categories["14"] != 2 {
+		t.Errorf("Expected 0.5 goals and 2 shots per game, got %v", seasonRate.Categories)
+	}
+	if _, ok := seasonRate.Categories["15"]; ok {
+		t.Errorf("Expected shooting percentage to be left out, got %v", seasonRate.Categories)
+	}
+
+	// Window games set the points rate but categories stay on the season
+	games := []*models.PlayerGameStat{{Goals: 2, Shots: 4}, {Shots: 2}}
+	windowRate := services.ProjectPlayerRate(modifiers, player, season, games, remaining)
+	if windowRate.Source != "gameLog" || windowRate.PointsPerGame != 4.5 || windowRate.Categories["1"] != 0.5 {
+		t.Errorf("Expected 4.5 points per game from the game log, got %+v", windowRate)
+	}
+
+	missing := services.ProjectPlayerRate(modifiers, player, nil, games, remaining)
+	if missing.Source != "season" || missing.PointsPerGame != 0 || len(missing.Categories) != 0 {
+		t.Errorf("Expected a player without season stats to rate zero, got %+v", missing)
+	}
+}
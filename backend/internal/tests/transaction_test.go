@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestBuildTransactionAnalysis(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 11, d, 12, 0, 0, 0, time.Local) }
+	transactions := []models.Transaction{
+		{TransactionKey: "tr.1", Type: "add", Status: "successful", Timestamp: day(1), FaabBid: 7, Players: []models.TransactionPlayer{
+			{PlayerKey: "p1", PlayerName: "Hot Pickup", Type: "add", SourceType: "waivers", DestinationTeamKey: "t1"},
+		}},
+		{TransactionKey: "tr.2", Type: "add/drop", Status: "successful", Timestamp: day(2), Players: []models.TransactionPlayer{
+			{PlayerKey: "p2", PlayerName: "Streamer", Type: "add", SourceType: "freeagents", DestinationTeamKey: "t2"},
+			{PlayerKey: "p3", PlayerName: "Cut", Type: "drop", SourceTeamKey: "t2", DestinationType: "waivers"},
+		}},
+		{TransactionKey: "tr.3", Type: "drop", Status: "successful", Timestamp: day(4), Players: []models.TransactionPlayer{
+			{PlayerKey: "p2", PlayerName: "Streamer", Type: "drop", SourceTeamKey: "t2", DestinationType: "waivers"},
+		}},
+		{TransactionKey: "tr.4", Type: "trade", Status: "proposed", Timestamp: day(5), TraderTeamKey: "t1", TradeeTeamKey: "t2"},
+	}
+	games := func(dates ...string) []*models.PlayerGameStat {
+		var log []*models.PlayerGameStat
+		for _, date := range dates {
+			log = append(log, &models.PlayerGameStat{GameDate: date, Goals: 1, Points: 1})
+		}
+		return log
+	}
+	gameLogs := map[string][]*models.PlayerGameStat{
+		// p1 is never dropped, so only the season bound keeps next season's game out
+		"p1": games("2024-10-30", "2024-11-01", "2024-11-03", "2025-10-10"),
+		"p2": games("2024-11-02", "2024-11-03", "2024-11-04"),
+	}
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}}
+
+	analysis := services.BuildTransactionAnalysis(transactions, map[string]string{"t1": "One", "t2": "Two", "t3": "Idle"}, modifiers, gameLogs)
+
+	if len(analysis.MostAdded) != 2 || len(analysis.MostDropped) != 2 || analysis.MostDropped[0].PlayerName != "Cut" {
+		t.Errorf("Unexpected leaders added %+v dropped %+v", analysis.MostAdded, analysis.MostDropped)
+	}
+	if len(analysis.Managers) != 3 || analysis.Managers[0].TeamKey != "t2" || analysis.Managers[2].TeamName != "Idle" {
+		t.Fatalf("Expected Two most active and Idle last, got %+v", analysis.Managers)
+	}
+	two, one := analysis.Managers[0], analysis.Managers[1]
+	if two.Adds != 1 || two.Drops != 2 || two.Trades != 0 || two.PickupPoints != 6 {
+		t.Errorf("Expected Two to count the add, both drops and 6 pickup points without the proposed trade, got %+v", two)
+	}
+	if one.WaiverClaims != 1 || one.FaabSpent != 7 || one.PickupPoints != 6 {
+		t.Errorf("Expected One's $7 claim to score 6 points, got %+v", one)
+	}
+
+	for _, pickup := range analysis.Pickups {
+		if pickup.PlayerKey == "p2" && (pickup.DroppedAt != "2024-11-04" || pickup.Games != 2) {
+			t.Errorf("Expected the streamer to score two games before being dropped, got %+v", pickup)
+		}
+	}
+}
+
+func TestFilterTransactions(t *testing.T) {
+	transactions := []models.Transaction{
+		{TransactionKey: "a", Type: "trade", Timestamp: time.Date(2024, 11, 1, 12, 0, 0, 0, time.Local), TraderTeamKey: "t1", TradeeTeamKey: "t2"},
+		{TransactionKey: "b", Type: "add", Timestamp: time.Date(2024, 11, 5, 12, 0, 0, 0, time.Local), Players: []models.TransactionPlayer{
+			{PlayerKey: "p1", Type: "add", SourceType: "waivers", DestinationTeamKey: "t3"},
+		}},
+	}
+
+	if filtered := services.FilterTransactions(transactions, models.TransactionFilter{TeamKey: "t2"}); len(filtered) != 1 || filtered[0].TransactionKey != "a" {
+		t.Errorf("Expected the trade for t2, got %+v", filtered)
+	}
+	if filtered := services.FilterTransactions(transactions, models.TransactionFilter{Type: "waiver", Since: "2024-11-02"}); len(filtered) != 1 || filtered[0].TransactionKey != "b" {
+		t.Errorf("Expected the waiver claim after Nov 2, got %+v", filtered)
+	}
+	if filtered := services.FilterTransactions(transactions, models.TransactionFilter{PlayerKey: "p1", Until: "2024-11-04"}); len(filtered) != 0 {
+		t.Errorf("Expected no moves of p1 before Nov 4, got %+v", filtered)
+	}
+}
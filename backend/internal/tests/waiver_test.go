@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/models"
+	"github.com/mateuse/yahoo-fantasy-analyzer/internal/services"
+)
+
+func TestScoreWaiverPlayer(t *testing.T) {
+	modifiers := []models.StatModifier{{StatID: "1", Value: 3}, {StatID: "14", Value: 0.5}}
+	upcoming := map[string]int{"NJD": 3, "TOR": 2}
+
+	// Without games in the window the season rate carries over, and Yahoo's NJ is the NHL's NJD
+	season := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p1", Team: "NJ", SeasonPerGame: 2}, nil, upcoming)
+	if season.WindowSource != "season" || season.WindowPerGame != 2 || season.WindowGames != 0 {
+		t.Errorf("Expected the season rate of 2, got %+v", season)
+	}
+	if season.UpcomingGames != 3 || season.Projected != 6 {
+		t.Errorf("Expected 3 upcoming games for 6 projected points, got %d and %.2f", season.UpcomingGames, season.Projected)
+	}
+
+	// One goal and two shots, then two shots: 4 and 1 points
+	games := []*models.PlayerGameStat{{Goals: 1, Shots: 2}, {Shots: 2}}
+	hot := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p2", Team: "TOR", SeasonPerGame: 1}, games, upcoming)
+	if hot.WindowSource != "gameLog" || hot.WindowGames != 2 || hot.WindowPerGame != 2.5 {
+		t.Errorf("Expected 2.5 points per game over 2 logged games, got %+v", hot)
+	}
+	if hot.Projected != 5 {
+		t.Errorf("Expected 5 projected points, got %.2f", hot.Projected)
+	}
+
+	// Without games, a better rank over the window than the season scales the season rate up,
+	// within bounds, and game logs still take precedence over ranks
+	rising := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p4", Team: "TOR", SeasonPerGame: 2, SeasonRank: 120, WindowRank: 100}, nil, upcoming)
+	if rising.WindowSource != "rank" || rising.WindowPerGame != 2.4 {
+		t.Errorf("Expected the season rate scaled to 2.4 by rank, got %+v", rising)
+	}
+	slumping := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p5", Team: "TOR", SeasonPerGame: 2, SeasonRank: 50, WindowRank: 400}, nil, upcoming)
+	if slumping.WindowSource != "rank" || slumping.WindowPerGame != 1 {
+		t.Errorf("Expected the season rate halved at most, got %+v", slumping)
+	}
+	unranked := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p6", Team: "TOR", SeasonPerGame: 2, WindowRank: 10}, nil, upcoming)
+	if unranked.WindowSource != "season" || unranked.WindowPerGame != 2 {
+		t.Errorf("Expected the season rate without a season rank, got %+v", unranked)
+	}
+	logged := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p7", Team: "TOR", SeasonPerGame: 1, SeasonRank: 200, WindowRank: 20}, games, upcoming)
+	if logged.WindowSource != "gameLog" || logged.WindowPerGame != 2.5 {
+		t.Errorf("Expected game logs over ranks, got %+v", logged)
+	}
+
+	idle := services.ScoreWaiverPlayer(modifiers, services.WaiverPlayer{PlayerKey: "p3", Team: "BOS", SeasonPerGame: 4}, nil, upcoming)
+	if idle.UpcomingGames != 0 || idle.Projected != 0 {
+		t.Errorf("Expected a team without games to project nothing, got %+v", idle)
+	}
+}
+
+func TestBuildWaiverCandidate(t *testing.T) {
+	rostered := []services.WaiverPlayer{
+		{PlayerKey: "c1", Name: "Center", EligiblePositions: []string{"C", "Util"}, WindowPerGame: 2, UpcomingGames: 3, Projected: 6},
+		{PlayerKey: "lw1", Name: "Weak Wing", EligiblePositions: []string{"LW", "Util"}, WindowPerGame: 1, UpcomingGames: 2, Projected: 2},
+		{PlayerKey: "lw2", Name: "Strong Wing", EligiblePositions: []string{"LW", "RW", "Util"}, WindowPerGame: 3, UpcomingGames: 3, Projected: 9},
+		{PlayerKey: "g1", Name: "Goalie", EligiblePositions: []string{"G"}, WindowPerGame: 0.5, UpcomingGames: 2, Projected: 1},
+	}
+
+	tests := []struct {
+		name        string
+		freeAgent   services.WaiverPlayer
+		drop        string
+		position    string
+		gain        float64
+		recommended bool
+	}{
+		{"weakest at a shared position", services.WaiverPlayer{PlayerKey: "fa1", EligiblePositions: []string{"LW", "Util"}, Projected: 5}, "lw1", "LW", 3, true},
+		{"first shared position of a dual player", services.WaiverPlayer{PlayerKey: "fa2", EligiblePositions: []string{"C", "LW"}, Projected: 5}, "lw1", "LW", 3, true},
+		{"no upgrade", services.WaiverPlayer{PlayerKey: "fa3", EligiblePositions: []string{"C"}, Projected: 4}, "c1", "C", -2, false},
+		{"util alone is not shared", services.WaiverPlayer{PlayerKey: "fa4", EligiblePositions: []string{"D", "Util"}, Projected: 2}, "", "", 2, true},
+	}
+
+	for _, tc := range tests {
+		candidate := services.BuildWaiverCandidate(tc.freeAgent, rostered)
+		if candidate.Gain != tc.gain || candidate.Recommended != tc.recommended {
+			t.Errorf("%s: expected gain %.2f recommended %v, got %.2f %v", tc.name, tc.gain, tc.recommended, candidate.Gain, candidate.Recommended)
+		}
+		if tc.drop == "" {
+			if candidate.DropCandidate != nil {
+				t.Errorf("%s: expected no drop candidate, got %+v", tc.name, candidate.DropCandidate)
+			}
+			continue
+		}
+		if candidate.DropCandidate == nil || candidate.DropCandidate.PlayerKey != tc.drop || candidate.DropCandidate.Position != tc.position {
+			t.Errorf("%s: expected to drop %s at %s, got %+v", tc.name, tc.drop, tc.position, candidate.DropCandidate)
+		}
+	}
+}
+
+func TestSharedEligiblePosition(t *testing.T) {
+	tests := []struct {
+		a, b     []string
+		expected string
+	}{
+		{[]string{"C", "LW"}, []string{"LW", "RW"}, "LW"},
+		{[]string{"RW", "C"}, []string{"C", "RW"}, "RW"},
+		{[]string{"C", "Util"}, []string{"D", "Util"}, ""},
+		{[]string{"BN", "IR+", "G"}, []string{"BN", "IR+"}, ""},
+		{[]string{"G"}, []string{"G"}, "G"},
+		{nil, []string{"C"}, ""},
+	}
+
+	for _, tc := range tests {
+		if got := services.SharedEligiblePosition(tc.a, tc.b); got != tc.expected {
+			t.Errorf("SharedEligiblePosition(%v, %v): expected %q, got %q", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
+func TestMapPlayerRanksByKey(t *testing.T) {
+	response := map[string]interface{}{
+		"league": map[string]interface{}{
+			"players": map[string]interface{}{"player": []interface{}{
+				map[string]interface{}{
+					"player_key": "453.p.1",
+					"player_ranks": map[string]interface{}{"player_rank": []interface{}{
+						map[string]interface{}{"rank_type": "S", "rank_value": "12", "rank_season": "2024"},
+						map[string]interface{}{"rank_type": "L7", "rank_value": "3"},
+					}},
+				},
+				map[string]interface{}{
+					"player_key":   "453.p.2",
+					"player_ranks": map[string]interface{}{"player_rank": map[string]interface{}{"rank_type": "S", "rank_value": "140", "rank_season": "2024"}},
+				},
+				map[string]interface{}{"player_key": "453.p.3"},
+			}},
+		},
+	}
+
+	ranks, err := services.MapPlayerRanksByKey(response)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ranks) != 3 {
+		t.Fatalf("Expected ranks for 3 players, got %d", len(ranks))
+	}
+	if first := ranks["453.p.1"]; len(first) != 2 || first[0].RankValue != 12 || first[0].RankSeason != "2024" || first[1].RankType != "L7" {
+		t.Errorf("Unexpected ranks for the first player %+v", first)
+	}
+	if second := ranks["453.p.2"]; len(second) != 1 || second[0].RankValue != 140 {
+		t.Errorf("Expected a single rank object to map, got %+v", second)
+	}
+	if third := ranks["453.p.3"]; third == nil || len(third) != 0 {
+		t.Errorf("Expected an unranked player to have an empty list, got %+v", third)
+	}
+}
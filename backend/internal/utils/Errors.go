@@ -16,3 +16,20 @@ func IsNotFoundError(err error) bool {
 	_, ok := err.(*NotFoundError)
 	return ok
 }
+
+type BadRequestError struct {
+	Message string
+}
+
+func (e *BadRequestError) Error() string {
+	return e.Message
+}
+
+func NewBadRequestError(message string) error {
+	return &BadRequestError{Message: message}
+}
+
+func IsBadRequestError(err error) bool {
+	_, ok := err.(*BadRequestError)
+	return ok
+}
@@ -2,7 +2,9 @@ package utils
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -69,16 +71,37 @@ func GetTTL() time.Duration {
 }
 
 func GetCurrentNhlSeason() string {
-	now := time.Now()
-	year := now.Year()
+	return NhlSeasonForDate(time.Now())
+}
 
-	// NHL season starts in October and spans two years
-	if now.Month() >= time.July {
+// NhlSeasonForDate returns the season ("20242025") a date belongs to. The season rolls over on
+// September 1 so the summer still reports the season that just ended, including its playoffs,
+// rather than one with no games yet.
+func NhlSeasonForDate(date time.Time) string {
+	year := date.Year()
+	if date.Month() >= time.September {
 		return fmt.Sprintf("%d%d", year, year+1)
 	}
 	return fmt.Sprintf("%d%d", year-1, year)
 }
 
+// PreviousNhlSeasons returns the count seasons before season, most recent first
+func PreviousNhlSeasons(season string, count int) []string {
+	if len(season) != 8 {
+		return nil
+	}
+	startYear, err := strconv.Atoi(season[:4])
+	if err != nil {
+		return nil
+	}
+
+	seasons := make([]string, 0, count)
+	for i := 1; i <= count; i++ {
+		seasons = append(seasons, fmt.Sprintf("%d%d", startYear-i, startYear-i+1))
+	}
+	return seasons
+}
+
 func GetStartOfCurrentWeek() time.Time {
 	// Load EST location
 	loc, err := time.LoadLocation("America/New_York")
@@ -104,6 +127,15 @@ func GenerateMatchupKey(teamA, teamB, week string) string {
 	return fmt.Sprintf("%s_vs_%s_%s", teamB, teamA, week)
 }
 
+// YahooPlayerID drops the game prefix from a Yahoo player key, so "453.p.6743" and "465.p.6743"
+// both become "p.6743" and keys match across seasons
+func YahooPlayerID(playerKey string) string {
+	if index := strings.Index(playerKey, ".p."); index >= 0 {
+		return playerKey[index+1:]
+	}
+	return playerKey
+}
+
 func AdjustTimePST(givenTime *time.Time) (*time.Time, error) {
 	if givenTime == nil {
 		return nil, fmt.Errorf("given time is nil")
@@ -126,3 +158,40 @@ func AdjustTimePST(givenTime *time.Time) (*time.Time, error) {
 
 	return &nextDay8AM, nil
 }
+
+func RoundFloat(value float64, precision int) float64 {
+	factor := math.Pow(10, float64(precision))
+	return math.Round(value*factor) / factor
+}
+
+// GetList returns the value at key as a list. Converted XML collapses a single
+// repeated element into a plain value, so non-list values are wrapped.
+func GetList(data map[string]interface{}, key string) []interface{} {
+	switch val := data[key].(type) {
+	case []interface{}:
+		return val
+	case nil:
+		return nil
+	default:
+		return []interface{}{val}
+	}
+}
+
+// IsRuledOut reports whether a Yahoo player status keeps the player out of games. Day-to-day
+// players may still play.
+func IsRuledOut(status string) bool {
+	switch status {
+	case "O", "IR", "IR-LT", "IR-NR", "NA", "SUSP":
+		return true
+	}
+	return false
+}
+
+// IsBenchPosition reports whether a selected roster position does not score
+func IsBenchPosition(position string) bool {
+	switch position {
+	case "BN", "IR", "IR+", "NA", "":
+		return true
+	}
+	return false
+}
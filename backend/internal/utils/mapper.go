@@ -1,5 +1,7 @@
 package utils
 
+import "strings"
+
 func GetStatIDToNameMap() map[string]string {
 	return map[string]string{
 		"0":    "Games Played",
@@ -87,3 +89,24 @@ func GetNHLTeamAbbreviations() map[string]string {
 		"WPG": "Winnipeg Jets",
 	}
 }
+
+// ToNHLTeamAbbreviation converts a Yahoo editorial team abbreviation to the one used by the NHL API
+func ToNHLTeamAbbreviation(yahooAbbr string) string {
+	abbr := strings.ToUpper(yahooAbbr)
+
+	switch abbr {
+	case "LA":
+		return "LAK"
+	case "NJ":
+		return "NJD"
+	case "SJ":
+		return "SJS"
+	case "TB":
+		return "TBL"
+	case "MON":
+		return "MTL"
+	case "WAS":
+		return "WSH"
+	}
+	return abbr
+}